@@ -13,8 +13,10 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/jonny/opsai-bot/internal/adapter/inbound/api"
 	"github.com/jonny/opsai-bot/internal/adapter/inbound/slackbot"
 	"github.com/jonny/opsai-bot/internal/adapter/inbound/webhook"
+	"github.com/jonny/opsai-bot/internal/adapter/inbound/webhook/middleware"
 	"github.com/jonny/opsai-bot/internal/adapter/inbound/webhook/parser"
 	"github.com/jonny/opsai-bot/internal/adapter/outbound/kubernetes"
 	"github.com/jonny/opsai-bot/internal/adapter/outbound/llm/ollama"
@@ -73,6 +75,14 @@ func main() {
 	conversationRepo := sqlite.NewConversationRepo(store)
 	policyRepo := sqlite.NewPolicyRepo(store)
 
+	// Config is the source of truth for environment policies: configured
+	// environments are upserted and any others are removed from the store.
+	if err := syncPolicies(context.Background(), policyRepo, cfg.Policy); err != nil {
+		logger.Error("failed to sync environment policies", "error", err)
+		os.Exit(1)
+	}
+	logger.Info("environment policies synced", "count", len(cfg.Policy.Environments))
+
 	repos := service.Repositories{
 		Alerts:        alertRepo,
 		Analyses:      analysisRepo,
@@ -133,7 +143,10 @@ func main() {
 	analyzer := service.NewAnalyzer(llmClient, k8sExecutor)
 	planner := service.NewActionPlanner(k8sExecutor)
 	policyEval := service.NewPolicyEvaluator(policyRepo)
-	orchestrator := service.NewOrchestrator(analyzer, planner, policyEval, notifier, k8sExecutor, repos, logger)
+	orchestrator := service.NewOrchestrator(analyzer, planner, policyEval, notifier, k8sExecutor, repos, service.OrchestratorConfig{
+		NotifyNoAction: cfg.Slack.Interaction.NotifyNoAction,
+	}, logger)
+	analysisReviewer := service.NewAnalysisReviewer(analysisRepo, cfg.LLM.CostPer1KTokens)
 
 	// --- Webhook ---
 	reg := parser.NewRegistry()
@@ -179,6 +192,13 @@ func main() {
 	metricsMux := http.NewServeMux()
 	metricsMux.HandleFunc("/healthz", checker.LivenessHandler())
 	metricsMux.HandleFunc("/readyz", checker.ReadinessHandler())
+	if cfg.Server.AnalysisAPI.Enabled {
+		// The metrics port is reachable through the Service, so the review API
+		// (root causes, alert IDs) always sits behind a bearer token.
+		metricsMux.Handle("/api/analyses",
+			middleware.BearerAuth(cfg.Server.AnalysisAPI.Token)(api.NewAnalysisHandler(analysisReviewer, logger)))
+		logger.Info("analysis review API enabled", "port", cfg.Server.MetricsPort)
+	}
 	metricsServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.MetricsPort),
 		Handler: metricsMux,
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jonny/opsai-bot/internal/config"
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+// syncPolicies makes the policy store mirror the configured environments.
+// Environments missing from config are deleted, so evaluations for them fall
+// back to requiring approval.
+func syncPolicies(ctx context.Context, repo outbound.PolicyRepository, pc config.PolicyConfig) error {
+	for _, p := range policiesFromConfig(pc) {
+		if err := repo.Upsert(ctx, p); err != nil {
+			return fmt.Errorf("upserting policy %s: %w", p.Environment, err)
+		}
+	}
+
+	stored, err := repo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("listing policies: %w", err)
+	}
+	for _, p := range stored {
+		if _, ok := pc.Environments[p.Environment]; ok {
+			continue
+		}
+		if err := repo.Delete(ctx, p.Environment); err != nil {
+			return fmt.Errorf("deleting policy %s: %w", p.Environment, err)
+		}
+	}
+	return nil
+}
+
+// policiesFromConfig converts the configured environments into domain
+// policies, sorted by environment name. Global custom rules are attached to
+// every environment.
+func policiesFromConfig(pc config.PolicyConfig) []model.EnvironmentPolicy {
+	rules := make([]model.PolicyRule, 0, len(pc.CustomRules))
+	for _, r := range pc.CustomRules {
+		rules = append(rules, model.PolicyRule{
+			Name:        r.Name,
+			Description: r.Description,
+			Condition: model.PolicyCondition{
+				Field:    r.Condition.Field,
+				Operator: r.Condition.Operator,
+				Value:    r.Condition.Value,
+			},
+			Effect:   model.PolicyEffect(r.Effect),
+			Priority: r.Priority,
+		})
+	}
+
+	envs := make([]string, 0, len(pc.Environments))
+	for env := range pc.Environments {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+
+	policies := make([]model.EnvironmentPolicy, 0, len(envs))
+	for _, env := range envs {
+		ec := pc.Environments[env]
+		policies = append(policies, model.EnvironmentPolicy{
+			ID:                           "policy-" + env,
+			Environment:                  env,
+			Mode:                         model.PolicyMode(ec.Mode),
+			MaxAutoRisk:                  ec.MaxAutoRisk,
+			Approvers:                    ec.Approvers,
+			Namespaces:                   ec.Namespaces,
+			CustomRules:                  rules,
+			Enabled:                      true,
+			IrreversibleRequiresApproval: ec.IrreversibleRequiresApproval,
+		})
+	}
+	return policies
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jonny/opsai-bot/internal/adapter/outbound/persistence/sqlite"
+	"github.com/jonny/opsai-bot/internal/config"
+	"github.com/jonny/opsai-bot/internal/domain/model"
+)
+
+func TestPoliciesFromConfig(t *testing.T) {
+	pc := config.PolicyConfig{
+		Environments: map[string]config.EnvironmentPolicyConfig{
+			"staging": {Mode: "warn_auto", MaxAutoRisk: "medium", IrreversibleRequiresApproval: true},
+			"dev":     {Mode: "auto_fix", MaxAutoRisk: "medium", Namespaces: []string{"app"}},
+		},
+		CustomRules: []config.CustomRuleConfig{
+			{Name: "deny-delete", Condition: config.ConditionConfig{Field: "action.type", Operator: "eq", Value: "delete_pod"}, Effect: "deny", Priority: 1},
+		},
+	}
+
+	got := policiesFromConfig(pc)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 policies, got %d", len(got))
+	}
+	if got[0].Environment != "dev" || got[1].Environment != "staging" {
+		t.Errorf("expected policies sorted by environment, got %q, %q", got[0].Environment, got[1].Environment)
+	}
+	staging := got[1]
+	if staging.ID != "policy-staging" || staging.Mode != model.PolicyModeWarnAuto || !staging.Enabled {
+		t.Errorf("unexpected staging policy: %+v", staging)
+	}
+	if !staging.IrreversibleRequiresApproval {
+		t.Error("expected IrreversibleRequiresApproval to be carried over")
+	}
+	if len(got[0].CustomRules) != 1 || got[0].CustomRules[0].Effect != model.PolicyEffectDeny {
+		t.Errorf("expected global custom rule on every environment, got %+v", got[0].CustomRules)
+	}
+}
+
+func TestSyncPolicies_PrunesRemovedEnvironments(t *testing.T) {
+	store, err := sqlite.NewStore(sqlite.Config{Path: ":memory:", MaxOpenConns: 1, PragmaJournalMode: "WAL", PragmaBusyTimeout: 5000})
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	defer store.Close()
+	repo := sqlite.NewPolicyRepo(store)
+	ctx := context.Background()
+
+	first := config.PolicyConfig{Environments: map[string]config.EnvironmentPolicyConfig{
+		"dev":     {Mode: "auto_fix", MaxAutoRisk: "medium"},
+		"staging": {Mode: "warn_auto", MaxAutoRisk: "medium"},
+	}}
+	if err := syncPolicies(ctx, repo, first); err != nil {
+		t.Fatalf("first sync: %v", err)
+	}
+
+	second := config.PolicyConfig{Environments: map[string]config.EnvironmentPolicyConfig{
+		"dev": {Mode: "approval_required", MaxAutoRisk: "low"},
+	}}
+	if err := syncPolicies(ctx, repo, second); err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+
+	all, err := repo.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if len(all) != 1 || all[0].Environment != "dev" {
+		t.Fatalf("expected only dev to remain, got %+v", all)
+	}
+	if all[0].Mode != model.PolicyModeApprovalRequired {
+		t.Errorf("expected dev policy to be updated, got mode %q", all[0].Mode)
+	}
+}
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+// AnalysisHandler serves GET /api/analyses for reviewing model performance.
+type AnalysisHandler struct {
+	reviewer inbound.AnalysisReviewPort
+	logger   *slog.Logger
+}
+
+// NewAnalysisHandler creates a new AnalysisHandler backed by the given review port.
+func NewAnalysisHandler(reviewer inbound.AnalysisReviewPort, logger *slog.Logger) *AnalysisHandler {
+	return &AnalysisHandler{reviewer: reviewer, logger: logger}
+}
+
+type statsResponse struct {
+	Count          int64   `json:"count"`
+	AvgConfidence  float64 `json:"avg_confidence"`
+	AvgLatencyMs   float64 `json:"avg_latency_ms"`
+	PromptTokens   int64   `json:"prompt_tokens"`
+	ResponseTokens int64   `json:"response_tokens"`
+	EstimatedCost  float64 `json:"estimated_cost"`
+}
+
+type analysisResponse struct {
+	ID             string    `json:"id"`
+	AlertID        string    `json:"alert_id"`
+	Provider       string    `json:"provider"`
+	Model          string    `json:"model"`
+	RootCause      string    `json:"root_cause"`
+	Severity       string    `json:"severity"`
+	Confidence     float64   `json:"confidence"`
+	PromptTokens   int       `json:"prompt_tokens"`
+	ResponseTokens int       `json:"response_tokens"`
+	LatencyMs      int64     `json:"latency_ms"`
+	EstimatedCost  float64   `json:"estimated_cost"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type reviewResponse struct {
+	Stats      statsResponse            `json:"stats"`
+	ByProvider map[string]statsResponse `json:"by_provider"`
+	Analyses   []analysisResponse       `json:"analyses"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// nextCursorHeader carries the next page cursor for CSV exports.
+const nextCursorHeader = "X-Next-Cursor"
+
+// csvHeader lists the columns written by the CSV export.
+var csvHeader = []string{
+	"id", "alert_id", "provider", "model", "severity", "confidence",
+	"prompt_tokens", "response_tokens", "latency_ms", "estimated_cost", "created_at",
+}
+
+// ServeHTTP handles a review request:
+// 1. Parses filters, limit and cursor from the query string.
+// 2. Loads one page of matching analyses and aggregate stats over all matches.
+// 3. Writes JSON, or CSV when format=csv, with the next page cursor.
+func (h *AnalysisHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := parseReviewRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "format must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	review, err := h.reviewer.ReviewAnalyses(r.Context(), req)
+	if err != nil {
+		h.logger.Error("failed to review analyses", "error", err, "query", r.URL.RawQuery)
+		http.Error(w, "failed to load analyses", http.StatusInternalServerError)
+		return
+	}
+
+	next := encodeCursor(review.NextCursor)
+	if format == "csv" {
+		if next != "" {
+			w.Header().Set(nextCursorHeader, next)
+		}
+		writeCSV(w, review)
+		return
+	}
+
+	resp := reviewResponse{
+		Stats:      toStatsResponse(review.Overall),
+		ByProvider: make(map[string]statsResponse, len(review.ByProvider)),
+		Analyses:   make([]analysisResponse, 0, len(review.Analyses)),
+		NextCursor: next,
+	}
+	for provider, s := range review.ByProvider {
+		resp.ByProvider[provider] = toStatsResponse(s)
+	}
+	for _, ra := range review.Analyses {
+		a := ra.Analysis
+		resp.Analyses = append(resp.Analyses, analysisResponse{
+			ID:             a.ID,
+			AlertID:        a.AlertID,
+			Provider:       a.Provider,
+			Model:          a.Model,
+			RootCause:      a.RootCause,
+			Severity:       string(a.Severity),
+			Confidence:     a.Confidence,
+			PromptTokens:   a.PromptTokens,
+			ResponseTokens: a.ResponseTokens,
+			LatencyMs:      a.LatencyMs,
+			EstimatedCost:  ra.EstimatedCost,
+			CreatedAt:      a.CreatedAt,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// parseReviewRequest builds a review request from query parameters. limit must
+// be a positive integer; the reviewer caps it. cursor is a next_cursor value
+// from a previous response.
+func parseReviewRequest(r *http.Request) (inbound.AnalysisReviewRequest, error) {
+	filter, err := parseAnalysisFilter(r)
+	if err != nil {
+		return inbound.AnalysisReviewRequest{}, err
+	}
+	req := inbound.AnalysisReviewRequest{Filter: filter}
+
+	q := r.URL.Query()
+	if raw := q.Get("limit"); raw != "" {
+		if req.Limit, err = strconv.Atoi(raw); err != nil || req.Limit <= 0 {
+			return inbound.AnalysisReviewRequest{}, fmt.Errorf("limit must be a positive integer")
+		}
+	}
+	if raw := q.Get("cursor"); raw != "" {
+		if req.Cursor, err = decodeCursor(raw); err != nil {
+			return inbound.AnalysisReviewRequest{}, err
+		}
+	}
+	return req, nil
+}
+
+// parseAnalysisFilter builds an AnalysisFilter from query parameters. Times use
+// RFC 3339 and confidence bounds must lie within [0, 1].
+func parseAnalysisFilter(r *http.Request) (outbound.AnalysisFilter, error) {
+	q := r.URL.Query()
+	filter := outbound.AnalysisFilter{
+		Provider:    q.Get("provider"),
+		Model:       q.Get("model"),
+		Environment: q.Get("environment"),
+	}
+
+	var err error
+	if filter.MinConfidence, err = parseConfidence(q.Get("min_confidence"), "min_confidence"); err != nil {
+		return outbound.AnalysisFilter{}, err
+	}
+	if filter.MaxConfidence, err = parseConfidence(q.Get("max_confidence"), "max_confidence"); err != nil {
+		return outbound.AnalysisFilter{}, err
+	}
+	if filter.MinConfidence != nil && filter.MaxConfidence != nil && *filter.MinConfidence > *filter.MaxConfidence {
+		return outbound.AnalysisFilter{}, fmt.Errorf("min_confidence must not exceed max_confidence")
+	}
+
+	if filter.Since, err = parseTime(q.Get("since"), "since"); err != nil {
+		return outbound.AnalysisFilter{}, err
+	}
+	if filter.Until, err = parseTime(q.Get("until"), "until"); err != nil {
+		return outbound.AnalysisFilter{}, err
+	}
+	if filter.Since != nil && filter.Until != nil && filter.Since.After(*filter.Until) {
+		return outbound.AnalysisFilter{}, fmt.Errorf("since must not be after until")
+	}
+
+	return filter, nil
+}
+
+func parseConfidence(raw, name string) (*float64, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || v < 0 || v > 1 {
+		return nil, fmt.Errorf("%s must be a number between 0 and 1", name)
+	}
+	return &v, nil
+}
+
+func parseTime(raw, name string) (*time.Time, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+	}
+	return &t, nil
+}
+
+// encodeCursor renders a cursor as an opaque URL-safe token.
+func encodeCursor(c *outbound.AnalysisCursor) string {
+	if c == nil {
+		return ""
+	}
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(token string) (*outbound.AnalysisCursor, error) {
+	invalid := fmt.Errorf("cursor is invalid")
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, invalid
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, invalid
+	}
+	return &outbound.AnalysisCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+func toStatsResponse(s inbound.AnalysisStats) statsResponse {
+	return statsResponse{
+		Count:          s.Count,
+		AvgConfidence:  s.AvgConfidence,
+		AvgLatencyMs:   s.AvgLatencyMs,
+		PromptTokens:   s.PromptTokens,
+		ResponseTokens: s.ResponseTokens,
+		EstimatedCost:  s.EstimatedCost,
+	}
+}
+
+// writeCSV writes one row per analysis. Aggregates are left out so the export
+// loads cleanly into spreadsheets.
+func writeCSV(w http.ResponseWriter, review inbound.AnalysisReview) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="analyses.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvHeader)
+	for _, ra := range review.Analyses {
+		a := ra.Analysis
+		_ = cw.Write([]string{
+			a.ID,
+			a.AlertID,
+			a.Provider,
+			a.Model,
+			string(a.Severity),
+			strconv.FormatFloat(a.Confidence, 'f', -1, 64),
+			strconv.Itoa(a.PromptTokens),
+			strconv.Itoa(a.ResponseTokens),
+			strconv.FormatInt(a.LatencyMs, 10),
+			strconv.FormatFloat(ra.EstimatedCost, 'f', -1, 64),
+			a.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	cw.Flush()
+}
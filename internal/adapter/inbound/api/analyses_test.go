@@ -0,0 +1,207 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonny/opsai-bot/internal/adapter/inbound/api"
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+// fakeReviewer records the request it was called with and returns a canned review.
+type fakeReviewer struct {
+	req    inbound.AnalysisReviewRequest
+	review inbound.AnalysisReview
+	err    error
+}
+
+func (f *fakeReviewer) ReviewAnalyses(_ context.Context, req inbound.AnalysisReviewRequest) (inbound.AnalysisReview, error) {
+	f.req = req
+	return f.review, f.err
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func sampleReview() inbound.AnalysisReview {
+	a := model.NewAnalysis("alert-1", "claude", "sonnet").
+		WithDiagnosis("oom", model.SeverityCritical, 0.9, "").
+		WithTokenUsage(1000, 500, 1200)
+	stats := inbound.AnalysisStats{Count: 1, AvgConfidence: 0.9, AvgLatencyMs: 1200, PromptTokens: 1000, ResponseTokens: 500, EstimatedCost: 0.015}
+	return inbound.AnalysisReview{
+		Analyses:   []inbound.ReviewedAnalysis{{Analysis: a, EstimatedCost: 0.015}},
+		Overall:    stats,
+		ByProvider: map[string]inbound.AnalysisStats{"claude": stats},
+	}
+}
+
+func TestAnalysisHandler_JSON(t *testing.T) {
+	reviewer := &fakeReviewer{review: sampleReview()}
+	h := api.NewAnalysisHandler(reviewer, discardLogger())
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/api/analyses?provider=claude&environment=prod&min_confidence=0.5&max_confidence=1&since=2026-01-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if reviewer.req.Filter.Provider != "claude" || reviewer.req.Filter.Environment != "prod" {
+		t.Errorf("unexpected filter: %+v", reviewer.req.Filter)
+	}
+	if reviewer.req.Filter.MinConfidence == nil || *reviewer.req.Filter.MinConfidence != 0.5 {
+		t.Errorf("expected min_confidence=0.5, got %v", reviewer.req.Filter.MinConfidence)
+	}
+	if reviewer.req.Filter.Since == nil {
+		t.Error("expected since to be parsed")
+	}
+
+	var body struct {
+		Stats struct {
+			Count         int     `json:"count"`
+			EstimatedCost float64 `json:"estimated_cost"`
+		} `json:"stats"`
+		ByProvider map[string]json.RawMessage `json:"by_provider"`
+		Analyses   []map[string]any           `json:"analyses"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if body.Stats.Count != 1 || body.Stats.EstimatedCost != 0.015 {
+		t.Errorf("unexpected stats: %+v", body.Stats)
+	}
+	if _, ok := body.ByProvider["claude"]; !ok {
+		t.Error("expected claude in by_provider")
+	}
+	if len(body.Analyses) != 1 || body.Analyses[0]["provider"] != "claude" {
+		t.Errorf("unexpected analyses: %v", body.Analyses)
+	}
+}
+
+func TestAnalysisHandler_CSV(t *testing.T) {
+	h := api.NewAnalysisHandler(&fakeReviewer{review: sampleReview()}, discardLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/analyses?format=csv", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Errorf("expected text/csv, got %s", ct)
+	}
+	records, err := csv.NewReader(strings.NewReader(rec.Body.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("parsing csv: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header + 1 row, got %d rows", len(records))
+	}
+	if records[0][0] != "id" || records[1][2] != "claude" {
+		t.Errorf("unexpected csv content: %v", records)
+	}
+}
+
+func TestAnalysisHandler_BadRequest(t *testing.T) {
+	cases := []string{
+		"/api/analyses?min_confidence=abc",
+		"/api/analyses?max_confidence=1.5",
+		"/api/analyses?min_confidence=0.8&max_confidence=0.2",
+		"/api/analyses?since=yesterday",
+		"/api/analyses?since=2026-02-01T00:00:00Z&until=2026-01-01T00:00:00Z",
+		"/api/analyses?format=xml",
+		"/api/analyses?limit=0",
+		"/api/analyses?limit=ten",
+		"/api/analyses?cursor=not-a-cursor",
+	}
+	for _, url := range cases {
+		h := api.NewAnalysisHandler(&fakeReviewer{}, discardLogger())
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", url, rec.Code)
+		}
+	}
+}
+
+func TestAnalysisHandler_MethodNotAllowed(t *testing.T) {
+	h := api.NewAnalysisHandler(&fakeReviewer{}, discardLogger())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/analyses", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestAnalysisHandler_ReviewerError(t *testing.T) {
+	var logs bytes.Buffer
+	h := api.NewAnalysisHandler(&fakeReviewer{err: errors.New("db down")}, slog.New(slog.NewTextHandler(&logs, nil)))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/analyses", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "db down") {
+		t.Errorf("expected internal error to stay out of the response, got: %s", rec.Body.String())
+	}
+	if !strings.Contains(logs.String(), "db down") {
+		t.Errorf("expected reviewer error to be logged, got: %s", logs.String())
+	}
+}
+
+func TestAnalysisHandler_Paging(t *testing.T) {
+	review := sampleReview()
+	review.NextCursor = &outbound.AnalysisCursor{
+		CreatedAt: time.Date(2026, 3, 1, 12, 0, 0, 123456789, time.UTC),
+		ID:        "analysis-42",
+	}
+	reviewer := &fakeReviewer{review: review}
+	h := api.NewAnalysisHandler(reviewer, discardLogger())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/analyses?limit=1", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if reviewer.req.Limit != 1 || reviewer.req.Cursor != nil {
+		t.Errorf("unexpected paging request: %+v", reviewer.req)
+	}
+	var body struct {
+		NextCursor string `json:"next_cursor"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if body.NextCursor == "" {
+		t.Fatal("expected next_cursor in response")
+	}
+
+	// Feeding the cursor back must decode to the same position.
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/analyses?format=csv&cursor="+body.NextCursor, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	got := reviewer.req.Cursor
+	if got == nil || got.ID != "analysis-42" || !got.CreatedAt.Equal(review.NextCursor.CreatedAt) {
+		t.Errorf("expected cursor to round-trip, got %+v", got)
+	}
+	if rec.Header().Get("X-Next-Cursor") != body.NextCursor {
+		t.Errorf("expected X-Next-Cursor header on CSV export, got %q", rec.Header().Get("X-Next-Cursor"))
+	}
+}
@@ -58,9 +58,9 @@ func (b *Bot) processMessageEvent(ctx context.Context, ev *slackevents.MessageEv
 		return
 	}
 
-	if resp.Text != "" {
+	if text := template.FormatMessageReply(resp); text != "" {
 		_, _, postErr := b.client.PostMessageContext(ctx, ev.Channel,
-			slackapi.MsgOptionText(resp.Text, false),
+			slackapi.MsgOptionText(text, false),
 			slackapi.MsgOptionTS(ev.ThreadTimeStamp),
 		)
 		if postErr != nil {
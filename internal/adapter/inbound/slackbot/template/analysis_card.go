@@ -75,7 +75,7 @@ func BuildAnalysisBlocks(n outbound.AnalysisNotification) []slackapi.Block {
 				riskTag = fmt.Sprintf(" _(risk: %s)_", a.Risk)
 			}
 			actionLines = append(actionLines,
-				fmt.Sprintf("%d. *%s*%s", i+1, a.Description, riskTag))
+				fmt.Sprintf("%d. *%s*%s %s", i+1, a.Description, riskTag, ReversibilityLabel(a.Reversible)))
 			if a.Command != "" {
 				actionLines = append(actionLines, fmt.Sprintf("   `%s`", a.Command))
 			}
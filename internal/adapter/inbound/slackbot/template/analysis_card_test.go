@@ -122,3 +122,30 @@ func TestBuildAnalysisBlocks_HighConfidence(t *testing.T) {
 		t.Error("expected 100% confidence in blocks")
 	}
 }
+
+func TestBuildAnalysisBlocks_Reversibility(t *testing.T) {
+	n := outbound.AnalysisNotification{
+		AlertID:    "alert-rev",
+		RootCause:  "Stuck pod",
+		Confidence: 0.8,
+		Actions: []outbound.ActionNotification{
+			{Description: "Restart deployment", Risk: "low", Reversible: true},
+			{Description: "Delete PVC", Risk: "high", Reversible: false},
+		},
+	}
+
+	blocks := template.BuildAnalysisBlocks(n)
+
+	var actionsText string
+	for _, b := range blocks {
+		if s, ok := b.(*slackapi.SectionBlock); ok && s.Text != nil && containsString(s.Text.Text, "Suggested Actions") {
+			actionsText = s.Text.Text
+		}
+	}
+	if !containsString(actionsText, ":leftwards_arrow_with_hook: Reversible") {
+		t.Errorf("expected reversible marker in actions, got: %s", actionsText)
+	}
+	if !containsString(actionsText, ":no_entry: Irreversible") {
+		t.Errorf("expected irreversible marker in actions, got: %s", actionsText)
+	}
+}
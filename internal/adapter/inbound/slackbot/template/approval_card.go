@@ -13,6 +13,15 @@ const (
 	ActionIDReject  = "approval_reject"
 )
 
+// ReversibilityLabel renders whether an action can be undone, so approvers can
+// weigh irreversible changes before acting on them.
+func ReversibilityLabel(reversible bool) string {
+	if reversible {
+		return ":leftwards_arrow_with_hook: Reversible"
+	}
+	return ":no_entry: Irreversible"
+}
+
 // BuildApprovalBlocks constructs Block Kit blocks for an approval request.
 func BuildApprovalBlocks(req outbound.ApprovalNotification) []slackapi.Block {
 	header := slackapi.NewSectionBlock(
@@ -32,6 +41,8 @@ func BuildApprovalBlocks(req outbound.ApprovalNotification) []slackapi.Block {
 			fmt.Sprintf("*Requested By*\n%s", req.RequestedBy), false, false),
 		slackapi.NewTextBlockObject(slackapi.MarkdownType,
 			fmt.Sprintf("*Risk Level*\n%s", strings.ToUpper(req.Risk)), false, false),
+		slackapi.NewTextBlockObject(slackapi.MarkdownType,
+			fmt.Sprintf("*Rollback*\n%s", ReversibilityLabel(req.Reversible)), false, false),
 	}
 	fieldBlock := slackapi.NewSectionBlock(nil, fields, nil)
 
@@ -157,3 +157,40 @@ func TestBuildApprovalBlocks_NoCommands(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildApprovalBlocks_Reversibility(t *testing.T) {
+	cases := []struct {
+		reversible bool
+		want       string
+	}{
+		{true, "Reversible"},
+		{false, "Irreversible"},
+	}
+	for _, tc := range cases {
+		req := outbound.ApprovalNotification{
+			ActionID:    "action-rev",
+			Description: "Delete pod",
+			Risk:        "medium",
+			Reversible:  tc.reversible,
+			Environment: "production",
+		}
+
+		blocks := template.BuildApprovalBlocks(req)
+
+		found := false
+		for _, b := range blocks {
+			s, ok := b.(*slackapi.SectionBlock)
+			if !ok {
+				continue
+			}
+			for _, f := range s.Fields {
+				if containsString(f.Text, "Rollback") && containsString(f.Text, tc.want) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Errorf("reversible=%v: expected Rollback field containing %q", tc.reversible, tc.want)
+		}
+	}
+}
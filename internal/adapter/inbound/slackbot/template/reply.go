@@ -0,0 +1,38 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+)
+
+// FormatMessageReply renders a conversational reply together with any actions
+// the assistant suggested, so thread replies show risk and reversibility the
+// same way analysis cards do.
+func FormatMessageReply(resp inbound.MessageResponse) string {
+	if len(resp.SuggestedActions) == 0 {
+		return resp.Text
+	}
+
+	lines := make([]string, 0, len(resp.SuggestedActions)*2+2)
+	if resp.Text != "" {
+		lines = append(lines, resp.Text, "")
+	}
+	lines = append(lines, "*Suggested Actions*")
+	for i, a := range resp.SuggestedActions {
+		riskTag := ""
+		if a.Risk != "" {
+			riskTag = fmt.Sprintf(" _(risk: %s)_", a.Risk)
+		}
+		lines = append(lines,
+			fmt.Sprintf("%d. *%s*%s %s", i+1, a.Description, riskTag, ReversibilityLabel(a.Reversible)))
+		for _, c := range a.Commands {
+			lines = append(lines, fmt.Sprintf("   `%s`", c))
+		}
+	}
+	if resp.NeedsApproval {
+		lines = append(lines, "", ":warning: These actions require approval before execution.")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,42 @@
+package template_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jonny/opsai-bot/internal/adapter/inbound/slackbot/template"
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+)
+
+func TestFormatMessageReply_TextOnly(t *testing.T) {
+	got := template.FormatMessageReply(inbound.MessageResponse{Text: "looks healthy"})
+	if got != "looks healthy" {
+		t.Errorf("expected plain text reply, got: %s", got)
+	}
+}
+
+func TestFormatMessageReply_SuggestedActions(t *testing.T) {
+	resp := inbound.MessageResponse{
+		Text: "the rollout is stuck",
+		SuggestedActions: []inbound.SuggestedActionInfo{
+			{Description: "Restart deployment", Commands: []string{"kubectl rollout restart deployment/app"}, Risk: "low", Reversible: true},
+			{Description: "Delete PVC", Commands: []string{"kubectl delete pvc data"}, Risk: "high", Reversible: false},
+		},
+		NeedsApproval: true,
+	}
+
+	got := template.FormatMessageReply(resp)
+
+	for _, want := range []string{
+		"the rollout is stuck",
+		"Restart deployment",
+		"`kubectl rollout restart deployment/app`",
+		template.ReversibilityLabel(true),
+		template.ReversibilityLabel(false),
+		"require approval",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in reply, got: %s", want, got)
+		}
+	}
+}
@@ -92,6 +92,11 @@ func (e *Executor) ValidateCommand(command []string) outbound.CommandValidation
 	return outbound.CommandValidation{Allowed: true, Reason: reason, Risk: "low"}
 }
 
+// IsNamespaceBlocked reports whether namespace is configured as protected.
+func (e *Executor) IsNamespaceBlocked(namespace string) bool {
+	return e.whitelist.IsNamespaceBlocked(namespace)
+}
+
 // Exec runs the command inside the specified pod/container by shelling out to kubectl.
 // This approach avoids SPDY/streaming complexity and is straightforward to test/mock.
 func (e *Executor) Exec(ctx context.Context, req outbound.ExecRequest) (outbound.ExecResult, error) {
@@ -51,6 +51,16 @@ func TestIsNamespaceBlocked_ViaValidate(t *testing.T) {
 	}
 }
 
+func TestExecutor_IsNamespaceBlocked(t *testing.T) {
+	e := testExecutor()
+	if !e.IsNamespaceBlocked("kube-system") {
+		t.Error("expected kube-system to be blocked")
+	}
+	if e.IsNamespaceBlocked("default") {
+		t.Error("expected default to be allowed")
+	}
+}
+
 func TestValidateCommand_Empty(t *testing.T) {
 	e := testExecutor()
 	result := e.ValidateCommand([]string{})
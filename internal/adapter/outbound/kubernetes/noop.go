@@ -41,6 +41,10 @@ func (n *NoopExecutor) ValidateCommand(_ []string) outbound.CommandValidation {
 	return outbound.CommandValidation{Allowed: false, Reason: "kubernetes unavailable in local dev mode", Risk: "none"}
 }
 
+func (n *NoopExecutor) IsNamespaceBlocked(_ string) bool {
+	return false
+}
+
 func (n *NoopExecutor) Exec(_ context.Context, _ outbound.ExecRequest) (outbound.ExecResult, error) {
 	return outbound.ExecResult{}, nil
 }
@@ -43,6 +43,7 @@ func (n *NoopNotifier) NotifyAction(_ context.Context, threadID string, action o
 		"threadID", threadID,
 		"description", action.Description,
 		"status", action.Status,
+		"reversible", action.Reversible,
 	)
 	return nil
 }
@@ -51,6 +52,7 @@ func (n *NoopNotifier) RequestApproval(_ context.Context, req outbound.ApprovalN
 	n.logger.Info("noop: approval request",
 		"actionID", req.ActionID,
 		"description", req.Description,
+		"reversible", req.Reversible,
 		"environment", req.Environment,
 	)
 	return nil
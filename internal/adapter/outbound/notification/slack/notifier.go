@@ -85,6 +85,7 @@ func (n *Notifier) NotifyAction(ctx context.Context, threadID string, action out
 	if action.Risk != "" {
 		lines = append(lines, fmt.Sprintf("_Risk: %s_", action.Risk))
 	}
+	lines = append(lines, template.ReversibilityLabel(action.Reversible))
 
 	block := slackapi.NewSectionBlock(
 		slackapi.NewTextBlockObject(slackapi.MarkdownType, strings.Join(lines, "\n"), false, false),
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
 )
 
 // AnalysisRepo implements outbound.AnalysisRepository using SQLite.
@@ -100,8 +102,146 @@ func (r *AnalysisRepo) Update(ctx context.Context, a model.Analysis) (model.Anal
 	return a, nil
 }
 
+// List returns up to limit analyses matching filter, newest first, plus
+// per-provider aggregates over all matches. Both queries run in one
+// transaction so the page and the stats agree. Paging is keyed on
+// (created_at, id), so rows inserted while a client pages through results
+// never shift or repeat later pages.
+func (r *AnalysisRepo) List(ctx context.Context, filter outbound.AnalysisFilter, cursor *outbound.AnalysisCursor, limit int) (outbound.AnalysisPage, error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return outbound.AnalysisPage{}, fmt.Errorf("beginning analysis read: %w", err)
+	}
+	defer tx.Rollback() // read-only; nothing to commit
+
+	aggs, err := aggregateAnalyses(ctx, tx, filter)
+	if err != nil {
+		return outbound.AnalysisPage{}, err
+	}
+	items, err := listAnalyses(ctx, tx, filter, cursor, limit)
+	if err != nil {
+		return outbound.AnalysisPage{}, err
+	}
+	return outbound.AnalysisPage{Items: items, Aggregates: aggs}, nil
+}
+
+// listAnalyses selects one keyset page of analyses matching filter.
+func listAnalyses(ctx context.Context, tx *sql.Tx, filter outbound.AnalysisFilter, cursor *outbound.AnalysisCursor, limit int) ([]model.Analysis, error) {
+	where, args := buildAnalysisWhere(filter)
+	if cursor != nil {
+		keyset := "(created_at < ? OR (created_at = ? AND id < ?))"
+		if where == "" {
+			where = " WHERE " + keyset
+		} else {
+			where += " AND " + keyset
+		}
+		createdAt := cursor.CreatedAt.UTC()
+		args = append(args, createdAt, createdAt, cursor.ID)
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	q := `SELECT id, alert_id, provider, model, root_cause, severity, confidence, explanation,
+		k8s_context, prompt_tokens, response_tokens, latency_ms, created_at
+		FROM analyses` + where + ` ORDER BY created_at DESC, id DESC LIMIT ?`
+
+	rows, err := tx.QueryContext(ctx, q, append(args, limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("listing analyses: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.Analysis
+	for rows.Next() {
+		a, err := scanAnalysis(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning analysis: %w", err)
+		}
+		items = append(items, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating analyses: %w", err)
+	}
+	return items, nil
+}
+
+// aggregateAnalyses computes per-provider counts, averages and token sums for
+// all analyses matching filter, ordered by provider.
+func aggregateAnalyses(ctx context.Context, tx *sql.Tx, filter outbound.AnalysisFilter) ([]outbound.AnalysisAggregate, error) {
+	where, args := buildAnalysisWhere(filter)
+
+	q := `SELECT provider, COUNT(*), AVG(confidence), AVG(latency_ms),
+		COALESCE(SUM(prompt_tokens), 0), COALESCE(SUM(response_tokens), 0)
+		FROM analyses` + where + ` GROUP BY provider ORDER BY provider ASC`
+
+	rows, err := tx.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating analyses: %w", err)
+	}
+	defer rows.Close()
+
+	var results []outbound.AnalysisAggregate
+	for rows.Next() {
+		var agg outbound.AnalysisAggregate
+		if err := rows.Scan(
+			&agg.Provider, &agg.Count, &agg.AvgConfidence, &agg.AvgLatencyMs,
+			&agg.PromptTokens, &agg.ResponseTokens,
+		); err != nil {
+			return nil, fmt.Errorf("scanning analysis aggregate: %w", err)
+		}
+		results = append(results, agg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating analysis aggregates: %w", err)
+	}
+	return results, nil
+}
+
 // --- helpers ---
 
+// buildAnalysisWhere builds the WHERE clause shared by listAnalyses and
+// aggregateAnalyses. Environment lives on the parent alert, so it is matched
+// through a subquery.
+func buildAnalysisWhere(f outbound.AnalysisFilter) (string, []any) {
+	var clauses []string
+	var args []any
+
+	if f.Provider != "" {
+		clauses = append(clauses, "provider = ?")
+		args = append(args, f.Provider)
+	}
+	if f.Model != "" {
+		clauses = append(clauses, "model = ?")
+		args = append(args, f.Model)
+	}
+	if f.Environment != "" {
+		clauses = append(clauses, "alert_id IN (SELECT id FROM alerts WHERE environment = ?)")
+		args = append(args, f.Environment)
+	}
+	if f.MinConfidence != nil {
+		clauses = append(clauses, "confidence >= ?")
+		args = append(args, *f.MinConfidence)
+	}
+	if f.MaxConfidence != nil {
+		clauses = append(clauses, "confidence <= ?")
+		args = append(args, *f.MaxConfidence)
+	}
+	if f.Since != nil {
+		clauses = append(clauses, "created_at >= ?")
+		args = append(args, f.Since.UTC())
+	}
+	if f.Until != nil {
+		clauses = append(clauses, "created_at <= ?")
+		args = append(args, f.Until.UTC())
+	}
+
+	if len(clauses) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(clauses, " AND "), args
+}
+
 type analysisScanner interface {
 	Scan(dest ...any) error
 }
@@ -0,0 +1,139 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonny/opsai-bot/internal/adapter/outbound/persistence/sqlite"
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+func seedAnalyses(t *testing.T) (*sqlite.AnalysisRepo, []model.Analysis) {
+	t.Helper()
+	store := newTestStore(t)
+	alertRepo := sqlite.NewAlertRepo(store)
+	repo := sqlite.NewAnalysisRepo(store)
+	ctx := context.Background()
+
+	prodAlert := makeAlert("prod alert", "production")
+	stagingAlert := makeAlert("staging alert", "staging")
+	for _, a := range []model.Alert{prodAlert, stagingAlert} {
+		if _, err := alertRepo.Create(ctx, a); err != nil {
+			t.Fatalf("Create alert: %v", err)
+		}
+	}
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	analyses := []model.Analysis{
+		model.NewAnalysis(prodAlert.ID, "ollama", "llama3").WithDiagnosis("oom", model.SeverityCritical, 0.9, "").WithTokenUsage(100, 50, 2000),
+		model.NewAnalysis(prodAlert.ID, "claude", "sonnet").WithDiagnosis("oom", model.SeverityCritical, 0.4, "").WithTokenUsage(300, 100, 500),
+		model.NewAnalysis(stagingAlert.ID, "ollama", "mistral").WithDiagnosis("disk", model.SeverityWarning, 0.7, "").WithTokenUsage(200, 150, 1000),
+	}
+	for i := range analyses {
+		analyses[i].CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		if _, err := repo.Create(ctx, analyses[i]); err != nil {
+			t.Fatalf("Create analysis: %v", err)
+		}
+	}
+	return repo, analyses
+}
+
+func TestAnalysisRepo_List_WithFilters(t *testing.T) {
+	repo, _ := seedAnalyses(t)
+	ctx := context.Background()
+
+	// Filter by provider
+	page, err := repo.List(ctx, outbound.AnalysisFilter{Provider: "ollama"}, nil, 10)
+	if err != nil {
+		t.Fatalf("List by provider: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("items for ollama: got %d want 2", len(page.Items))
+	}
+
+	// Filter by environment of the parent alert
+	page, err = repo.List(ctx, outbound.AnalysisFilter{Environment: "production"}, nil, 10)
+	if err != nil {
+		t.Fatalf("List by environment: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("items for production: got %d want 2", len(page.Items))
+	}
+
+	// Filter by confidence range
+	minConf, maxConf := 0.5, 0.8
+	page, err = repo.List(ctx, outbound.AnalysisFilter{MinConfidence: &minConf, MaxConfidence: &maxConf}, nil, 10)
+	if err != nil {
+		t.Fatalf("List by confidence: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Model != "mistral" {
+		t.Errorf("confidence range: got %+v", page.Items)
+	}
+}
+
+func TestAnalysisRepo_List_Keyset(t *testing.T) {
+	repo, analyses := seedAnalyses(t)
+	ctx := context.Background()
+
+	page, err := repo.List(ctx, outbound.AnalysisFilter{}, nil, 2)
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+	first := page.Items
+	if len(first) != 2 || first[0].ID != analyses[2].ID || first[1].ID != analyses[1].ID {
+		t.Fatalf("expected newest two analyses first, got %+v", first)
+	}
+
+	// A newer analysis arriving between pages must not shift the next page.
+	late := model.NewAnalysis(analyses[0].AlertID, "ollama", "llama3")
+	late.CreatedAt = analyses[2].CreatedAt.Add(time.Hour)
+	if _, err := repo.Create(ctx, late); err != nil {
+		t.Fatalf("Create late analysis: %v", err)
+	}
+
+	last := first[len(first)-1]
+	page, err = repo.List(ctx, outbound.AnalysisFilter{}, &outbound.AnalysisCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 2)
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+	second := page.Items
+	if len(second) != 1 || second[0].ID != analyses[0].ID {
+		t.Errorf("expected only the oldest analysis on the second page, got %+v", second)
+	}
+}
+
+func TestAnalysisRepo_List_Aggregates(t *testing.T) {
+	repo, _ := seedAnalyses(t)
+
+	// Aggregates cover every match, not just the requested page.
+	page, err := repo.List(context.Background(), outbound.AnalysisFilter{}, nil, 1)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(page.Items) != 1 {
+		t.Errorf("expected a single item on the page, got %d", len(page.Items))
+	}
+	aggs := page.Aggregates
+	if len(aggs) != 2 || aggs[0].Provider != "claude" || aggs[1].Provider != "ollama" {
+		t.Fatalf("expected claude and ollama aggregates, got %+v", aggs)
+	}
+
+	ollama := aggs[1]
+	if ollama.Count != 2 || ollama.PromptTokens != 300 || ollama.ResponseTokens != 200 {
+		t.Errorf("unexpected ollama sums: %+v", ollama)
+	}
+	if ollama.AvgConfidence < 0.799 || ollama.AvgConfidence > 0.801 || ollama.AvgLatencyMs != 1500 {
+		t.Errorf("unexpected ollama averages: %+v", ollama)
+	}
+
+	page, err = repo.List(context.Background(), outbound.AnalysisFilter{Environment: "staging"}, nil, 10)
+	if err != nil {
+		t.Fatalf("List by environment: %v", err)
+	}
+	aggs = page.Aggregates
+	if len(aggs) != 1 || aggs[0].Count != 1 {
+		t.Errorf("expected one staging aggregate, got %+v", aggs)
+	}
+}
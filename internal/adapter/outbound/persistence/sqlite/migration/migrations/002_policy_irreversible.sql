@@ -0,0 +1,2 @@
+-- Per-environment switch forcing approval for irreversible actions
+ALTER TABLE policies ADD COLUMN irreversible_requires_approval BOOLEAN DEFAULT 0;
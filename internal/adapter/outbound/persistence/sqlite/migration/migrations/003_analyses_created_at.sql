@@ -0,0 +1,2 @@
+-- Supports newest-first keyset paging for the analysis review API
+CREATE INDEX IF NOT EXISTS idx_analyses_created_at ON analyses(created_at, id);
@@ -10,19 +10,58 @@ import (
 var migrationsFS embed.FS
 
 // Run executes all embedded SQL migration files in lexicographic order.
+// Applied files are recorded in schema_migrations so that non-idempotent
+// statements such as ALTER TABLE run only once.
 func Run(db *sql.DB) error {
+	const createTracking = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version TEXT PRIMARY KEY,
+		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`
+	if _, err := db.Exec(createTracking); err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
 	entries, err := migrationsFS.ReadDir("migrations")
 	if err != nil {
 		return fmt.Errorf("reading migrations: %w", err)
 	}
 	for _, entry := range entries {
+		var applied int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations WHERE version = ?`, entry.Name()).Scan(&applied); err != nil {
+			return fmt.Errorf("checking %s: %w", entry.Name(), err)
+		}
+		if applied > 0 {
+			continue
+		}
+
 		data, err := migrationsFS.ReadFile("migrations/" + entry.Name())
 		if err != nil {
 			return fmt.Errorf("reading %s: %w", entry.Name(), err)
 		}
-		if _, err := db.Exec(string(data)); err != nil {
-			return fmt.Errorf("executing %s: %w", entry.Name(), err)
+		if err := apply(db, entry.Name(), string(data)); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+// apply runs a single migration and records it in one transaction, so a
+// failure while recording cannot leave a half-applied schema behind.
+func apply(db *sql.DB, name, stmts string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning %s: %w", name, err)
+	}
+	defer tx.Rollback() // no-op once committed
+
+	if _, err := tx.Exec(stmts); err != nil {
+		return fmt.Errorf("executing %s: %w", name, err)
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, name); err != nil {
+		return fmt.Errorf("recording %s: %w", name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing %s: %w", name, err)
+	}
+	return nil
+}
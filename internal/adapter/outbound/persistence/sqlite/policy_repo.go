@@ -21,7 +21,8 @@ func NewPolicyRepo(store *Store) *PolicyRepo {
 
 // GetByEnvironment fetches the policy for a specific environment.
 func (r *PolicyRepo) GetByEnvironment(ctx context.Context, env string) (model.EnvironmentPolicy, error) {
-	const q = `SELECT id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled
+	const q = `SELECT id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled,
+		irreversible_requires_approval
 		FROM policies WHERE environment = ?`
 
 	row := r.db.QueryRowContext(ctx, q, env)
@@ -37,7 +38,8 @@ func (r *PolicyRepo) GetByEnvironment(ctx context.Context, env string) (model.En
 
 // GetAll returns all stored environment policies.
 func (r *PolicyRepo) GetAll(ctx context.Context) ([]model.EnvironmentPolicy, error) {
-	const q = `SELECT id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled
+	const q = `SELECT id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled,
+		irreversible_requires_approval
 		FROM policies ORDER BY environment ASC`
 
 	rows, err := r.db.QueryContext(ctx, q)
@@ -72,8 +74,9 @@ func (r *PolicyRepo) Upsert(ctx context.Context, p model.EnvironmentPolicy) erro
 		return fmt.Errorf("marshaling custom_rules: %w", err)
 	}
 
-	const q = `INSERT INTO policies (id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled)
-		VALUES (?,?,?,?,?,?,?,?)
+	const q = `INSERT INTO policies (id, environment, mode, max_auto_risk, approvers, namespaces, custom_rules, enabled,
+		irreversible_requires_approval)
+		VALUES (?,?,?,?,?,?,?,?,?)
 		ON CONFLICT(environment) DO UPDATE SET
 			id=excluded.id,
 			mode=excluded.mode,
@@ -81,11 +84,13 @@ func (r *PolicyRepo) Upsert(ctx context.Context, p model.EnvironmentPolicy) erro
 			approvers=excluded.approvers,
 			namespaces=excluded.namespaces,
 			custom_rules=excluded.custom_rules,
-			enabled=excluded.enabled`
+			enabled=excluded.enabled,
+			irreversible_requires_approval=excluded.irreversible_requires_approval`
 
 	_, err = r.db.ExecContext(ctx, q,
 		p.ID, p.Environment, string(p.Mode), p.MaxAutoRisk,
 		string(approvers), string(namespaces), string(customRules), p.Enabled,
+		p.IrreversibleRequiresApproval,
 	)
 	if err != nil {
 		return fmt.Errorf("upserting policy: %w", err)
@@ -93,6 +98,14 @@ func (r *PolicyRepo) Upsert(ctx context.Context, p model.EnvironmentPolicy) erro
 	return nil
 }
 
+// Delete removes the policy for an environment. Deleting a missing policy is not an error.
+func (r *PolicyRepo) Delete(ctx context.Context, env string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM policies WHERE environment = ?`, env); err != nil {
+		return fmt.Errorf("deleting policy: %w", err)
+	}
+	return nil
+}
+
 // --- helpers ---
 
 type policyScanner interface {
@@ -106,6 +119,7 @@ func scanPolicy(s policyScanner) (model.EnvironmentPolicy, error) {
 	err := s.Scan(
 		&p.ID, &p.Environment, &mode, &p.MaxAutoRisk,
 		&approversJSON, &namespacesJSON, &customRulesJSON, &p.Enabled,
+		&p.IrreversibleRequiresApproval,
 	)
 	if err != nil {
 		return model.EnvironmentPolicy{}, err
@@ -69,6 +69,7 @@ func TestPolicyRepo_UpsertAndGet(t *testing.T) {
 	updated := policy
 	updated.Mode = model.PolicyModeAutoFix
 	updated.Approvers = []string{"charlie"}
+	updated.IrreversibleRequiresApproval = true
 	if err := repo.Upsert(ctx, updated); err != nil {
 		t.Fatalf("Upsert (update): %v", err)
 	}
@@ -83,6 +84,9 @@ func TestPolicyRepo_UpsertAndGet(t *testing.T) {
 	if len(got2.Approvers) != 1 || got2.Approvers[0] != "charlie" {
 		t.Errorf("Approvers after update: got %v", got2.Approvers)
 	}
+	if !got2.IrreversibleRequiresApproval {
+		t.Errorf("IrreversibleRequiresApproval after update: got false")
+	}
 
 	// GetAll
 	if err := repo.Upsert(ctx, makePolicy("staging", model.PolicyModeWarnAuto)); err != nil {
@@ -95,4 +99,15 @@ func TestPolicyRepo_UpsertAndGet(t *testing.T) {
 	if len(all) != 2 {
 		t.Errorf("GetAll len: got %d want 2", len(all))
 	}
+
+	// Delete
+	if err := repo.Delete(ctx, "staging"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.GetByEnvironment(ctx, "staging"); err == nil {
+		t.Error("expected staging policy to be gone after Delete")
+	}
+	if err := repo.Delete(ctx, "staging"); err != nil {
+		t.Errorf("Delete of missing policy: %v", err)
+	}
 }
@@ -0,0 +1,97 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/jonny/opsai-bot/internal/adapter/outbound/persistence/sqlite"
+	"github.com/jonny/opsai-bot/internal/domain/model"
+)
+
+func TestStore_ReopenRunsMigrationsOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "opsai.db")
+	cfg := sqlite.Config{Path: path, MaxOpenConns: 1, PragmaJournalMode: "WAL", PragmaBusyTimeout: 5000}
+
+	first, err := sqlite.NewStore(cfg)
+	if err != nil {
+		t.Fatalf("first NewStore: %v", err)
+	}
+	policy := makePolicy("staging", model.PolicyModeWarnAuto)
+	policy.IrreversibleRequiresApproval = true
+	if err := sqlite.NewPolicyRepo(first).Upsert(context.Background(), policy); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	_ = first.Close()
+
+	second, err := sqlite.NewStore(cfg)
+	if err != nil {
+		t.Fatalf("second NewStore: %v", err)
+	}
+	t.Cleanup(func() { _ = second.Close() })
+
+	got, err := sqlite.NewPolicyRepo(second).GetByEnvironment(context.Background(), "staging")
+	if err != nil {
+		t.Fatalf("GetByEnvironment: %v", err)
+	}
+	if !got.IrreversibleRequiresApproval {
+		t.Error("expected IrreversibleRequiresApproval to survive reopen")
+	}
+}
+
+func TestStore_FailedMigrationRollsBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "opsai.db")
+	cfg := sqlite.Config{Path: path, MaxOpenConns: 1, PragmaJournalMode: "WAL", PragmaBusyTimeout: 5000}
+
+	raw, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("open raw db: %v", err)
+	}
+	t.Cleanup(func() { _ = raw.Close() })
+
+	// Reject the policy migration's bookkeeping row so recording it fails
+	// after its ALTER TABLE has already run.
+	if _, err := raw.Exec(`CREATE TABLE schema_migrations (
+		version TEXT PRIMARY KEY CHECK (version <> '002_policy_irreversible.sql'),
+		applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		t.Fatalf("create schema_migrations: %v", err)
+	}
+
+	if _, err := sqlite.NewStore(cfg); err == nil {
+		t.Fatal("expected NewStore to fail when recording a migration fails")
+	}
+
+	var cols int
+	if err := raw.QueryRow(
+		`SELECT COUNT(*) FROM pragma_table_info('policies') WHERE name = 'irreversible_requires_approval'`,
+	).Scan(&cols); err != nil {
+		t.Fatalf("inspect policies: %v", err)
+	}
+	if cols != 0 {
+		t.Fatal("expected ALTER TABLE to be rolled back with the failed migration")
+	}
+
+	// Swap in an unconstrained tracking table that keeps the versions already
+	// recorded, then retry.
+	for _, stmt := range []string{
+		`CREATE TABLE schema_migrations_new (
+			version TEXT PRIMARY KEY,
+			applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`INSERT INTO schema_migrations_new SELECT * FROM schema_migrations`,
+		`DROP TABLE schema_migrations`,
+		`ALTER TABLE schema_migrations_new RENAME TO schema_migrations`,
+	} {
+		if _, err := raw.Exec(stmt); err != nil {
+			t.Fatalf("recreate schema_migrations: %v", err)
+		}
+	}
+
+	store, err := sqlite.NewStore(cfg)
+	if err != nil {
+		t.Fatalf("expected retry to succeed, got: %v", err)
+	}
+	_ = store.Close()
+}
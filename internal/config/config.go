@@ -20,11 +20,19 @@ type Config struct {
 }
 
 type ServerConfig struct {
-	Port            int           `yaml:"port"`
-	ReadTimeout     time.Duration `yaml:"readTimeout"`
-	WriteTimeout    time.Duration `yaml:"writeTimeout"`
-	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
-	MetricsPort     int           `yaml:"metricsPort"`
+	Port            int               `yaml:"port"`
+	ReadTimeout     time.Duration     `yaml:"readTimeout"`
+	WriteTimeout    time.Duration     `yaml:"writeTimeout"`
+	ShutdownTimeout time.Duration     `yaml:"shutdownTimeout"`
+	MetricsPort     int               `yaml:"metricsPort"`
+	AnalysisAPI     AnalysisAPIConfig `yaml:"analysisAPI"`
+}
+
+// AnalysisAPIConfig controls GET /api/analyses on the metrics port. It is off
+// by default and, when enabled, requires a bearer token.
+type AnalysisAPIConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Token   string `yaml:"token"`
 }
 
 type LLMConfig struct {
@@ -34,6 +42,8 @@ type LLMConfig struct {
 	OpenAI              OpenAIConfig `yaml:"openai"`
 	MaxAnalysisRetries  int          `yaml:"maxAnalysisRetries"`
 	ConfidenceThreshold float64      `yaml:"confidenceThreshold"`
+	// CostPer1KTokens maps provider name to price per 1,000 tokens for analysis review.
+	CostPer1KTokens map[string]float64 `yaml:"costPer1kTokens"`
 }
 
 type OllamaConfig struct {
@@ -112,6 +122,7 @@ type InteractionConfig struct {
 	ApprovalTimeout    time.Duration `yaml:"approvalTimeout"`
 	AutoExecDelay      time.Duration `yaml:"autoExecDelay"`
 	ThreadHistoryLimit int           `yaml:"threadHistoryLimit"`
+	NotifyNoAction     bool          `yaml:"notifyNoAction"`
 }
 
 type PolicyConfig struct {
@@ -120,10 +131,11 @@ type PolicyConfig struct {
 }
 
 type EnvironmentPolicyConfig struct {
-	Mode        string   `yaml:"mode"`
-	MaxAutoRisk string   `yaml:"maxAutoRisk"`
-	Approvers   []string `yaml:"approvers"`
-	Namespaces  []string `yaml:"namespaces"`
+	Mode                         string   `yaml:"mode"`
+	MaxAutoRisk                  string   `yaml:"maxAutoRisk"`
+	Approvers                    []string `yaml:"approvers"`
+	Namespaces                   []string `yaml:"namespaces"`
+	IrreversibleRequiresApproval bool     `yaml:"irreversibleRequiresApproval"`
 }
 
 type CustomRuleConfig struct {
@@ -243,12 +255,13 @@ func DefaultConfig() *Config {
 				ApprovalTimeout:    30 * time.Minute,
 				AutoExecDelay:      5 * time.Second,
 				ThreadHistoryLimit: 50,
+				NotifyNoAction:     true,
 			},
 		},
 		Policy: PolicyConfig{
 			Environments: map[string]EnvironmentPolicyConfig{
 				"dev":     {Mode: "auto_fix", MaxAutoRisk: "medium"},
-				"staging": {Mode: "warn_auto", MaxAutoRisk: "medium"},
+				"staging": {Mode: "warn_auto", MaxAutoRisk: "medium", IrreversibleRequiresApproval: true},
 				"prod":    {Mode: "approval_required", MaxAutoRisk: "low", Approvers: []string{"@oncall-team"}},
 			},
 		},
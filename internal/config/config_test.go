@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -212,6 +213,45 @@ func TestValidate_InvalidPort(t *testing.T) {
 	}
 }
 
+func TestValidate_AnalysisAPIRequiresToken(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Slack.Enabled = false
+	cfg.Server.AnalysisAPI.Enabled = true
+
+	err := Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "server.analysisAPI.token") {
+		t.Errorf("expected analysisAPI token error, got %v", err)
+	}
+
+	cfg.Server.AnalysisAPI.Token = "s3cret"
+	if err := Validate(cfg); err == nil || !strings.Contains(err.Error(), "at least") {
+		t.Errorf("expected short token error, got %v", err)
+	}
+
+	cfg.Server.AnalysisAPI.Token = "0123456789abcdef"
+	if err := Validate(cfg); err != nil {
+		t.Errorf("expected valid config with token, got %v", err)
+	}
+}
+
+func TestLoad_AnalysisAPITokenEnvUnset(t *testing.T) {
+	t.Setenv("ANALYSIS_API_TOKEN", "")
+	os.Unsetenv("ANALYSIS_API_TOKEN")
+	path := writeTempYAML(t, `
+slack:
+  enabled: false
+server:
+  analysisAPI:
+    enabled: true
+    token: "${ANALYSIS_API_TOKEN}"
+`)
+
+	_, err := Load(path)
+	if err == nil || !strings.Contains(err.Error(), "unexpanded environment variable") {
+		t.Errorf("expected unexpanded token error, got %v", err)
+	}
+}
+
 func TestValidate_InvalidPort_TooHigh(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Slack.Enabled = false
@@ -5,6 +5,9 @@ import (
 	"strings"
 )
 
+// minAnalysisAPITokenLen is the shortest bearer token accepted for the analysis API.
+const minAnalysisAPITokenLen = 16
+
 // Validate checks the config for errors.
 func Validate(cfg *Config) error {
 	var errs []string
@@ -62,6 +65,19 @@ func Validate(cfg *Config) error {
 	if cfg.Server.MetricsPort == cfg.Server.Port {
 		errs = append(errs, "server.metricsPort must differ from server.port")
 	}
+	if cfg.Server.AnalysisAPI.Enabled {
+		// Load leaves ${VAR} in place when VAR is unset; never accept the
+		// placeholder itself as the secret.
+		token := cfg.Server.AnalysisAPI.Token
+		switch {
+		case token == "":
+			errs = append(errs, "server.analysisAPI.token is required when the analysis API is enabled")
+		case strings.Contains(token, "${"):
+			errs = append(errs, fmt.Sprintf("server.analysisAPI.token contains an unexpanded environment variable (%s)", token))
+		case len(token) < minAnalysisAPITokenLen:
+			errs = append(errs, fmt.Sprintf("server.analysisAPI.token must be at least %d characters", minAnalysisAPITokenLen))
+		}
+	}
 
 	// Validate execTimeout.
 	if cfg.Kubernetes.ExecTimeout <= 0 {
@@ -73,6 +89,12 @@ func Validate(cfg *Config) error {
 		errs = append(errs, "webhook.rateLimit.requestsPerMinute must be positive when enabled")
 	}
 
+	for provider, cost := range cfg.LLM.CostPer1KTokens {
+		if cost < 0 {
+			errs = append(errs, fmt.Sprintf("llm.costPer1kTokens.%s must not be negative", provider))
+		}
+	}
+
 	// Validate maxAutoRisk in policies.
 	validRisks := map[string]bool{"low": true, "medium": true, "high": true, "critical": true}
 	for name, env := range cfg.Policy.Environments {
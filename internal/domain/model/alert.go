@@ -13,6 +13,9 @@ const (
 	AlertStatusFailed    AlertStatus = "failed"
 	AlertStatusDuplicate AlertStatus = "duplicate"
 	AlertStatusSilenced  AlertStatus = "silenced"
+	// AlertStatusManualRequired marks an analyzed alert the bot took no
+	// automated action on; it stays open until a human handles it.
+	AlertStatusManualRequired AlertStatus = "manual_required"
 )
 
 type AlertSource string
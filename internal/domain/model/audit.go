@@ -16,6 +16,9 @@ const (
 	AuditActionFailed      AuditEventType = "action.failed"
 	AuditPolicyEvaluated   AuditEventType = "policy.evaluated"
 	AuditConversation      AuditEventType = "conversation.message"
+	// AuditRemediationSkipped records that no remediation was planned, e.g.
+	// because the alert's namespace is protected.
+	AuditRemediationSkipped AuditEventType = "remediation.skipped"
 )
 
 type AuditLog struct {
@@ -121,6 +121,7 @@ func TestAlert_IsTerminal(t *testing.T) {
 		{AlertStatusAnalyzing, false},
 		{AlertStatusAnalyzed, false},
 		{AlertStatusActing, false},
+		{AlertStatusManualRequired, false},
 		{AlertStatusResolved, true},
 		{AlertStatusFailed, true},
 		{AlertStatusDuplicate, true},
@@ -25,6 +25,9 @@ type EnvironmentPolicy struct {
 	Namespaces  []string     `json:"namespaces" yaml:"namespaces"`
 	CustomRules []PolicyRule `json:"custom_rules" yaml:"customRules"`
 	Enabled     bool         `json:"enabled" yaml:"enabled"`
+	// IrreversibleRequiresApproval forces approval for actions that cannot be
+	// undone, even when the mode and risk would otherwise allow auto-execution.
+	IrreversibleRequiresApproval bool `json:"irreversible_requires_approval" yaml:"irreversibleRequiresApproval"`
 }
 
 type PolicyRule struct {
@@ -0,0 +1,43 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+// AnalysisReviewPort exposes past LLM analyses for model performance review.
+type AnalysisReviewPort interface {
+	ReviewAnalyses(ctx context.Context, req AnalysisReviewRequest) (AnalysisReview, error)
+}
+
+// AnalysisReviewRequest selects analyses to review. Stats always cover every
+// match; Cursor and Limit only page the returned rows.
+type AnalysisReviewRequest struct {
+	Filter outbound.AnalysisFilter
+	Cursor *outbound.AnalysisCursor
+	Limit  int
+}
+
+type AnalysisStats struct {
+	Count          int64
+	AvgConfidence  float64
+	AvgLatencyMs   float64
+	PromptTokens   int64
+	ResponseTokens int64
+	EstimatedCost  float64
+}
+
+type ReviewedAnalysis struct {
+	Analysis      model.Analysis
+	EstimatedCost float64
+}
+
+type AnalysisReview struct {
+	Analyses   []ReviewedAnalysis
+	Overall    AnalysisStats
+	ByProvider map[string]AnalysisStats
+	// NextCursor is set when more rows follow this page.
+	NextCursor *outbound.AnalysisCursor
+}
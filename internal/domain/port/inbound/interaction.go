@@ -27,6 +27,7 @@ type SuggestedActionInfo struct {
 	Description string
 	Commands    []string
 	Risk        string
+	Reversible  bool
 }
 
 type ApprovalRequest struct {
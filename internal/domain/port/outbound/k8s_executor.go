@@ -44,6 +44,8 @@ type K8sExecutor interface {
 	DescribeResource(ctx context.Context, namespace, resourceType, name string) (string, error)
 	GetClusterContext(ctx context.Context) (string, error)
 	ValidateCommand(command []string) CommandValidation
+	// IsNamespaceBlocked reports whether namespace is protected from mutation.
+	IsNamespaceBlocked(namespace string) bool
 	Exec(ctx context.Context, req ExecRequest) (ExecResult, error)
 	RestartDeployment(ctx context.Context, namespace, name string) error
 	ScaleDeployment(ctx context.Context, namespace, name string, replicas int32) error
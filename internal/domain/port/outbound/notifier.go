@@ -38,6 +38,7 @@ type ActionNotification struct {
 	Status      string
 	Output      string
 	Risk        string
+	Reversible  bool
 }
 
 type ApprovalNotification struct {
@@ -47,6 +48,7 @@ type ApprovalNotification struct {
 	Description string
 	Commands    []string
 	Risk        string
+	Reversible  bool
 	Environment string
 	RequestedBy string
 }
@@ -30,6 +30,39 @@ type AlertFilter struct {
 	Until       *time.Time
 }
 
+type AnalysisFilter struct {
+	Provider      string
+	Model         string
+	Environment   string
+	MinConfidence *float64
+	MaxConfidence *float64
+	Since         *time.Time
+	Until         *time.Time
+}
+
+// AnalysisCursor marks a position in analyses ordered newest first. IDs break
+// ties between analyses created at the same instant.
+type AnalysisCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// AnalysisAggregate holds metrics summed or averaged over one provider's analyses.
+type AnalysisAggregate struct {
+	Provider       string
+	Count          int64
+	AvgConfidence  float64
+	AvgLatencyMs   float64
+	PromptTokens   int64
+	ResponseTokens int64
+}
+
+// AnalysisPage is one page of analyses plus aggregates over all matches.
+type AnalysisPage struct {
+	Items      []model.Analysis
+	Aggregates []AnalysisAggregate
+}
+
 type AuditFilter struct {
 	AlertID     string
 	ActionType  string
@@ -52,6 +85,11 @@ type AnalysisRepository interface {
 	GetByID(ctx context.Context, id string) (model.Analysis, error)
 	GetByAlertID(ctx context.Context, alertID string) ([]model.Analysis, error)
 	Update(ctx context.Context, analysis model.Analysis) (model.Analysis, error)
+	// List returns up to limit analyses matching filter, newest first, starting
+	// strictly after cursor (or from the newest when cursor is nil), together
+	// with per-provider aggregates over every match. Both are read from the
+	// same snapshot.
+	List(ctx context.Context, filter AnalysisFilter, cursor *AnalysisCursor, limit int) (AnalysisPage, error)
 }
 
 type ActionRepository interface {
@@ -71,6 +109,7 @@ type PolicyRepository interface {
 	GetByEnvironment(ctx context.Context, env string) (model.EnvironmentPolicy, error)
 	GetAll(ctx context.Context) ([]model.EnvironmentPolicy, error)
 	Upsert(ctx context.Context, policy model.EnvironmentPolicy) error
+	Delete(ctx context.Context, env string) error
 }
 
 type ConversationRepository interface {
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+)
+
+const (
+	// DefaultReviewLimit is the page size used when a review request sets none.
+	DefaultReviewLimit = 100
+	// MaxReviewLimit caps how many analyses a single review page returns.
+	MaxReviewLimit = 1000
+)
+
+// AnalysisReviewer aggregates stored analyses so providers and models can be
+// compared on confidence, latency, token usage and cost.
+type AnalysisReviewer struct {
+	repo            outbound.AnalysisRepository
+	costPer1KTokens map[string]float64
+}
+
+// NewAnalysisReviewer creates a new AnalysisReviewer. costPer1KTokens maps a
+// provider name to its price per 1,000 tokens; unknown providers cost nothing.
+func NewAnalysisReviewer(repo outbound.AnalysisRepository, costPer1KTokens map[string]float64) *AnalysisReviewer {
+	return &AnalysisReviewer{repo: repo, costPer1KTokens: costPer1KTokens}
+}
+
+// Ensure AnalysisReviewer satisfies the inbound port at compile time.
+var _ inbound.AnalysisReviewPort = (*AnalysisReviewer)(nil)
+
+// ReviewAnalyses returns one page of matching analyses, newest first, together
+// with overall and per-provider statistics computed by the repository over all
+// matches.
+func (r *AnalysisReviewer) ReviewAnalyses(ctx context.Context, req inbound.AnalysisReviewRequest) (inbound.AnalysisReview, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultReviewLimit
+	}
+	if limit > MaxReviewLimit {
+		limit = MaxReviewLimit
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	page, err := r.repo.List(ctx, req.Filter, req.Cursor, limit+1)
+	if err != nil {
+		return inbound.AnalysisReview{}, fmt.Errorf("list analyses: %w", err)
+	}
+	analyses, aggs := page.Items, page.Aggregates
+	var next *outbound.AnalysisCursor
+	if len(analyses) > limit {
+		analyses = analyses[:limit]
+		last := analyses[limit-1]
+		next = &outbound.AnalysisCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	reviewed := make([]inbound.ReviewedAnalysis, 0, len(analyses))
+	for _, a := range analyses {
+		reviewed = append(reviewed, inbound.ReviewedAnalysis{Analysis: a, EstimatedCost: r.cost(a)})
+	}
+
+	var overall inbound.AnalysisStats
+	var confidenceSum, latencySum float64
+	byProvider := make(map[string]inbound.AnalysisStats, len(aggs))
+	for _, agg := range aggs {
+		s := inbound.AnalysisStats{
+			Count:          agg.Count,
+			AvgConfidence:  agg.AvgConfidence,
+			AvgLatencyMs:   agg.AvgLatencyMs,
+			PromptTokens:   agg.PromptTokens,
+			ResponseTokens: agg.ResponseTokens,
+			EstimatedCost:  r.tokenCost(agg.Provider, agg.PromptTokens+agg.ResponseTokens),
+		}
+		byProvider[agg.Provider] = s
+
+		overall.Count += s.Count
+		overall.PromptTokens += s.PromptTokens
+		overall.ResponseTokens += s.ResponseTokens
+		overall.EstimatedCost += s.EstimatedCost
+		confidenceSum += s.AvgConfidence * float64(s.Count)
+		latencySum += s.AvgLatencyMs * float64(s.Count)
+	}
+	if overall.Count > 0 {
+		overall.AvgConfidence = confidenceSum / float64(overall.Count)
+		overall.AvgLatencyMs = latencySum / float64(overall.Count)
+	}
+
+	return inbound.AnalysisReview{
+		Analyses:   reviewed,
+		Overall:    overall,
+		ByProvider: byProvider,
+		NextCursor: next,
+	}, nil
+}
+
+// cost estimates the spend for a single analysis from its token usage.
+func (r *AnalysisReviewer) cost(a model.Analysis) float64 {
+	return r.tokenCost(a.Provider, int64(a.PromptTokens+a.ResponseTokens))
+}
+
+// tokenCost prices a token count at the provider's configured rate.
+func (r *AnalysisReviewer) tokenCost(provider string, tokens int64) float64 {
+	return float64(tokens) / 1000 * r.costPer1KTokens[provider]
+}
@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jonny/opsai-bot/internal/domain/model"
+	"github.com/jonny/opsai-bot/internal/domain/port/inbound"
+	"github.com/jonny/opsai-bot/internal/domain/port/outbound"
+	"github.com/jonny/opsai-bot/internal/domain/service"
+)
+
+// --- mock AnalysisRepository with keyset paging ---
+
+// reviewAnalysisRepo serves items (newest first) and canned aggregates, and
+// records how it was called.
+type reviewAnalysisRepo struct {
+	mockAnalysisRepo
+	items      []model.Analysis
+	aggregates []outbound.AnalysisAggregate
+	lastFilter outbound.AnalysisFilter
+	lastCursor *outbound.AnalysisCursor
+	lastLimit  int
+}
+
+func (r *reviewAnalysisRepo) List(_ context.Context, f outbound.AnalysisFilter, cursor *outbound.AnalysisCursor, limit int) (outbound.AnalysisPage, error) {
+	r.lastFilter, r.lastCursor, r.lastLimit = f, cursor, limit
+	start := 0
+	if cursor != nil {
+		for i, a := range r.items {
+			if a.ID == cursor.ID {
+				start = i + 1
+			}
+		}
+	}
+	end := start + limit
+	if end > len(r.items) {
+		end = len(r.items)
+	}
+	return outbound.AnalysisPage{Items: r.items[start:end], Aggregates: r.aggregates}, nil
+}
+
+func reviewAnalysis(provider string, prompt, response int) model.Analysis {
+	return model.NewAnalysis("alert-1", provider, "m").
+		WithDiagnosis("cause", model.SeverityWarning, 0.5, "").
+		WithTokenUsage(prompt, response, 100)
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestAnalysisReviewer_ReviewAnalyses_Stats(t *testing.T) {
+	repo := &reviewAnalysisRepo{
+		items: []model.Analysis{reviewAnalysis("claude", 2000, 1000)},
+		aggregates: []outbound.AnalysisAggregate{
+			{Provider: "claude", Count: 1, AvgConfidence: 0.9, AvgLatencyMs: 500, PromptTokens: 2000, ResponseTokens: 1000},
+			{Provider: "ollama", Count: 3, AvgConfidence: 0.5, AvgLatencyMs: 1500, PromptTokens: 1500, ResponseTokens: 1000},
+		},
+	}
+	reviewer := service.NewAnalysisReviewer(repo, map[string]float64{"claude": 0.01})
+
+	review, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{
+		Filter: outbound.AnalysisFilter{Environment: "prod"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastFilter.Environment != "prod" {
+		t.Errorf("expected filter to be passed through, got %+v", repo.lastFilter)
+	}
+
+	claude := review.ByProvider["claude"]
+	if !almostEqual(claude.EstimatedCost, 0.03) {
+		t.Errorf("expected claude cost 0.03, got %v", claude.EstimatedCost)
+	}
+	if ollama := review.ByProvider["ollama"]; ollama.Count != 3 || ollama.EstimatedCost != 0 {
+		t.Errorf("unexpected ollama stats: %+v", ollama)
+	}
+	if len(review.Analyses) != 1 || !almostEqual(review.Analyses[0].EstimatedCost, 0.03) {
+		t.Errorf("expected per-analysis cost 0.03, got %+v", review.Analyses)
+	}
+
+	overall := review.Overall
+	if overall.Count != 4 || overall.PromptTokens != 3500 || overall.ResponseTokens != 2000 {
+		t.Errorf("unexpected overall sums: %+v", overall)
+	}
+	if !almostEqual(overall.AvgConfidence, 0.6) || !almostEqual(overall.AvgLatencyMs, 1250) {
+		t.Errorf("expected count-weighted averages, got %+v", overall)
+	}
+	if !almostEqual(overall.EstimatedCost, 0.03) {
+		t.Errorf("expected overall cost 0.03, got %v", overall.EstimatedCost)
+	}
+	if review.NextCursor != nil {
+		t.Errorf("expected no next cursor, got %+v", review.NextCursor)
+	}
+}
+
+func TestAnalysisReviewer_ReviewAnalyses_Pages(t *testing.T) {
+	items := make([]model.Analysis, 5)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range items {
+		items[i] = reviewAnalysis("ollama", 10, 10)
+		items[i].CreatedAt = base.Add(-time.Duration(i) * time.Minute)
+	}
+	repo := &reviewAnalysisRepo{items: items}
+	reviewer := service.NewAnalysisReviewer(repo, nil)
+
+	first, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Analyses) != 2 || first.NextCursor == nil {
+		t.Fatalf("expected 2 analyses and a next cursor, got %d, %+v", len(first.Analyses), first.NextCursor)
+	}
+	if first.NextCursor.ID != items[1].ID || !first.NextCursor.CreatedAt.Equal(items[1].CreatedAt) {
+		t.Errorf("expected cursor at the last returned analysis, got %+v", first.NextCursor)
+	}
+
+	last, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{Limit: 3, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(last.Analyses) != 3 || last.NextCursor != nil {
+		t.Errorf("expected final page of 3 with no cursor, got %d, %+v", len(last.Analyses), last.NextCursor)
+	}
+}
+
+func TestAnalysisReviewer_ReviewAnalyses_ClampsLimit(t *testing.T) {
+	repo := &reviewAnalysisRepo{}
+	reviewer := service.NewAnalysisReviewer(repo, nil)
+
+	if _, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastLimit != service.DefaultReviewLimit+1 {
+		t.Errorf("expected default limit plus lookahead, got %d", repo.lastLimit)
+	}
+
+	if _, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{Limit: 1_000_000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lastLimit != service.MaxReviewLimit+1 {
+		t.Errorf("expected max limit plus lookahead, got %d", repo.lastLimit)
+	}
+}
+
+func TestAnalysisReviewer_ReviewAnalyses_Empty(t *testing.T) {
+	reviewer := service.NewAnalysisReviewer(&reviewAnalysisRepo{}, nil)
+
+	review, err := reviewer.ReviewAnalyses(context.Background(), inbound.AnalysisReviewRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if review.Overall.Count != 0 || review.Overall.AvgConfidence != 0 {
+		t.Errorf("expected zero stats, got %+v", review.Overall)
+	}
+	if len(review.ByProvider) != 0 {
+		t.Errorf("expected no provider stats, got %v", review.ByProvider)
+	}
+}
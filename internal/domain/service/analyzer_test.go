@@ -53,6 +53,7 @@ type mockK8s struct {
 	validateResult outbound.CommandValidation
 	execResult     outbound.ExecResult
 	execErr        error
+	blockedNS      []string
 }
 
 func (m *mockK8s) GetResource(_ context.Context, _ outbound.ResourceQuery) (outbound.ResourceResult, error) {
@@ -73,6 +74,14 @@ func (m *mockK8s) GetClusterContext(_ context.Context) (string, error) {
 func (m *mockK8s) ValidateCommand(_ []string) outbound.CommandValidation {
 	return m.validateResult
 }
+func (m *mockK8s) IsNamespaceBlocked(namespace string) bool {
+	for _, ns := range m.blockedNS {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
 func (m *mockK8s) Exec(_ context.Context, _ outbound.ExecRequest) (outbound.ExecResult, error) {
 	return m.execResult, m.execErr
 }
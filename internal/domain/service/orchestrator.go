@@ -21,6 +21,13 @@ type Repositories struct {
 	Conversations outbound.ConversationRepository
 }
 
+// OrchestratorConfig holds tunable orchestrator behaviour.
+type OrchestratorConfig struct {
+	// NotifyNoAction posts a thread message when analysis produces no
+	// executable actions, so responders know manual work is needed.
+	NotifyNoAction bool
+}
+
 // Orchestrator ties the analysis, planning and policy sub-services together and
 // implements both AlertReceiverPort and InteractionPort.
 type Orchestrator struct {
@@ -30,6 +37,7 @@ type Orchestrator struct {
 	notifier   outbound.Notifier
 	k8s        outbound.K8sExecutor
 	repos      Repositories
+	cfg        OrchestratorConfig
 	logger     *slog.Logger
 }
 
@@ -41,6 +49,7 @@ func NewOrchestrator(
 	notifier outbound.Notifier,
 	k8s outbound.K8sExecutor,
 	repos Repositories,
+	cfg OrchestratorConfig,
 	logger *slog.Logger,
 ) *Orchestrator {
 	return &Orchestrator{
@@ -50,6 +59,7 @@ func NewOrchestrator(
 		notifier:   notifier,
 		k8s:        k8s,
 		repos:      repos,
+		cfg:        cfg,
 		logger:     logger,
 	}
 }
@@ -124,12 +134,21 @@ func (o *Orchestrator) HandleMessage(ctx context.Context, req inbound.MessageReq
 	)
 	o.logAudit(ctx, auditLog)
 
+	// Alerts in protected namespaces never get automated remediation, so
+	// don't offer actions in the thread either.
+	if alert, lookupErr := o.repos.Alerts.GetByID(ctx, req.AlertID); lookupErr == nil && o.k8s.IsNamespaceBlocked(alert.Namespace) {
+		return inbound.MessageResponse{
+			Text: fmt.Sprintf("%s\n\n_Namespace %q is protected — automated remediation is disabled, so no actions are suggested._", resp.Reply, alert.Namespace),
+		}, nil
+	}
+
 	suggested := make([]inbound.SuggestedActionInfo, 0, len(resp.SuggestedActions))
 	for _, sa := range resp.SuggestedActions {
 		suggested = append(suggested, inbound.SuggestedActionInfo{
 			Description: sa.Description,
 			Commands:    sa.Commands,
 			Risk:        sa.Risk,
+			Reversible:  sa.Reversible,
 		})
 	}
 
@@ -218,10 +237,22 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 		fmt.Sprintf("root cause: %s (confidence %.2f)", analysis.RootCause, analysis.Confidence),
 	))
 
-	// 5. Plan actions.
-	actions, err := o.planner.Plan(ctx, analysis.ID, alert.ID, suggestions, alert.Environment, alert.Namespace)
-	if err != nil {
-		return fmt.Errorf("plan actions: %w", err)
+	// 5. Plan actions, unless the namespace is protected from mutation.
+	var actions []model.Action
+	protected := o.k8s.IsNamespaceBlocked(alert.Namespace)
+	if protected {
+		o.logAudit(ctx, model.NewAuditLog(
+			model.AuditRemediationSkipped,
+			alert.ID,
+			"system",
+			alert.Environment,
+			fmt.Sprintf("namespace %q is protected; remediation planning skipped", alert.Namespace),
+		))
+	} else {
+		actions, err = o.planner.Plan(ctx, analysis.ID, alert.ID, suggestions, alert.Environment, alert.Namespace)
+		if err != nil {
+			return fmt.Errorf("plan actions: %w", err)
+		}
 	}
 
 	// Notify analysis result.
@@ -232,6 +263,7 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 			Command:     strings.Join(a.Commands, " && "),
 			Status:      string(a.Status),
 			Risk:        string(a.Risk),
+			Reversible:  a.Reversible,
 		})
 	}
 	if notifyErr := o.notifier.NotifyAnalysis(ctx, outbound.AnalysisNotification{
@@ -246,13 +278,23 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 		o.logger.Error("failed to notify analysis", "error", notifyErr, "alert_id", alert.ID)
 	}
 
+	if protected {
+		msg := fmt.Sprintf("Namespace %q is protected — automated remediation is disabled; manual intervention needed.\n*Root cause:* %s", alert.Namespace, analysis.RootCause)
+		if notifyErr := o.notifier.SendMessage(ctx, threadID, msg, outbound.NotificationWarning); notifyErr != nil {
+			o.logger.Error("failed to notify protected namespace", "error", notifyErr, "alert_id", alert.ID)
+		}
+	}
+
 	// 6. For each action: evaluate policy and execute or request approval.
 	alert = alert.WithStatus(model.AlertStatusActing)
 	if _, updateErr := o.repos.Alerts.Update(ctx, alert); updateErr != nil {
 		o.logger.Error("failed to update alert status", "error", updateErr, "alert_id", alert.ID)
 	}
 
+	// actionable counts actions that were sent for approval or executed;
+	// rejected or unevaluated actions do not remediate anything.
 	allResolved := true
+	actionable := 0
 	for _, action := range actions {
 		decision, evalErr := o.policyEval.Evaluate(ctx, alert.Environment, action)
 		if evalErr != nil {
@@ -266,7 +308,7 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 			alert.ID,
 			"system",
 			alert.Environment,
-			fmt.Sprintf("policy decision for action %s: allowed=%v needsApproval=%v", action.Description, decision.Allowed, decision.NeedsApproval),
+			fmt.Sprintf("policy decision for action %s: allowed=%v needsApproval=%v reversible=%v", action.Description, decision.Allowed, decision.NeedsApproval, action.Reversible),
 		).WithActionID(action.ID))
 
 		if !decision.Allowed {
@@ -294,11 +336,13 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 				Description: action.Description,
 				Commands:    action.Commands,
 				Risk:        string(action.Risk),
+				Reversible:  action.Reversible,
 				Environment: alert.Environment,
 				RequestedBy: "system",
 			}); notifyErr != nil {
 				o.logger.Error("failed to request approval", "error", notifyErr, "alert_id", alert.ID, "action_id", action.ID)
 			}
+			actionable++
 			allResolved = false
 			continue
 		}
@@ -310,6 +354,7 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 			allResolved = false
 			continue
 		}
+		actionable++
 		executedAction, execErr := o.executeAction(ctx, action)
 		if execErr != nil {
 			allResolved = false
@@ -319,8 +364,24 @@ func (o *Orchestrator) HandleAlert(ctx context.Context, alert model.Alert) error
 		}
 	}
 
-	// 7. Update final alert status.
-	if allResolved {
+	if actionable == 0 && !protected && o.cfg.NotifyNoAction {
+		reason := "no automated remediation available"
+		if len(actions) > 0 {
+			reason = "all suggested actions were blocked by policy"
+		}
+		msg := fmt.Sprintf("Analysis complete — %s; manual intervention needed.\n*Root cause:* %s", reason, analysis.RootCause)
+		if notifyErr := o.notifier.SendMessage(ctx, threadID, msg, outbound.NotificationWarning); notifyErr != nil {
+			o.logger.Error("failed to notify no-action result", "error", notifyErr, "alert_id", alert.ID)
+		}
+	}
+
+	// 7. Update final alert status. When no action was executed or sent for
+	// approval nothing was fixed, so the alert stays open for a human instead
+	// of counting as resolved.
+	switch {
+	case actionable == 0:
+		alert = alert.WithStatus(model.AlertStatusManualRequired)
+	case allResolved:
 		alert = alert.WithStatus(model.AlertStatusResolved)
 	}
 	if _, updateErr := o.repos.Alerts.Update(ctx, alert); updateErr != nil {
@@ -431,6 +492,7 @@ func (o *Orchestrator) executeAction(ctx context.Context, action model.Action) (
 		Status:      string(action.Status),
 		Output:      output,
 		Risk:        string(action.Risk),
+		Reversible:  action.Reversible,
 	}); notifyErr != nil {
 		o.logger.Error("failed to notify action", "error", notifyErr, "action_id", action.ID)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,6 +72,9 @@ func (m *mockAnalysisRepo) GetByAlertID(_ context.Context, _ string) ([]model.An
 func (m *mockAnalysisRepo) Update(_ context.Context, a model.Analysis) (model.Analysis, error) {
 	return a, nil
 }
+func (m *mockAnalysisRepo) List(_ context.Context, _ outbound.AnalysisFilter, _ *outbound.AnalysisCursor, _ int) (outbound.AnalysisPage, error) {
+	return outbound.AnalysisPage{}, nil
+}
 
 var _ outbound.AnalysisRepository = (*mockAnalysisRepo)(nil)
 
@@ -113,9 +117,14 @@ func (r *mockActionRepo) GetPendingApprovals(_ context.Context, _ string) ([]mod
 
 var _ outbound.ActionRepository = (*mockActionRepo)(nil)
 
-type mockAuditRepo struct{}
+type mockAuditRepo struct {
+	logs []model.AuditLog
+}
 
-func (m *mockAuditRepo) Create(_ context.Context, _ model.AuditLog) error { return nil }
+func (m *mockAuditRepo) Create(_ context.Context, l model.AuditLog) error {
+	m.logs = append(m.logs, l)
+	return nil
+}
 func (m *mockAuditRepo) List(_ context.Context, _ outbound.AuditFilter, _ outbound.PageRequest) (outbound.PageResult[model.AuditLog], error) {
 	return outbound.PageResult[model.AuditLog]{}, nil
 }
@@ -157,6 +166,8 @@ type mockNotifier struct {
 	threadID      string
 	notifyAlertFn func(outbound.AlertNotification)
 	requestApprovalCalled bool
+	lastApproval          outbound.ApprovalNotification
+	messages              []string
 }
 
 func (m *mockNotifier) NotifyAlert(_ context.Context, n outbound.AlertNotification) (string, error) {
@@ -171,11 +182,13 @@ func (m *mockNotifier) NotifyAnalysis(_ context.Context, _ outbound.AnalysisNoti
 func (m *mockNotifier) NotifyAction(_ context.Context, _ string, _ outbound.ActionNotification) error {
 	return nil
 }
-func (m *mockNotifier) RequestApproval(_ context.Context, _ outbound.ApprovalNotification) error {
+func (m *mockNotifier) RequestApproval(_ context.Context, req outbound.ApprovalNotification) error {
 	m.requestApprovalCalled = true
+	m.lastApproval = req
 	return nil
 }
-func (m *mockNotifier) SendMessage(_ context.Context, _ string, _ string, _ outbound.NotificationLevel) error {
+func (m *mockNotifier) SendMessage(_ context.Context, _ string, message string, _ outbound.NotificationLevel) error {
+	m.messages = append(m.messages, message)
 	return nil
 }
 
@@ -190,17 +203,33 @@ func buildOrchestrator(
 	notifier outbound.Notifier,
 	actionRepo *mockActionRepo,
 ) *service.Orchestrator {
-	analyzer := service.NewAnalyzer(llm, k8sMock)
-	planner := service.NewActionPlanner(k8sMock)
-	policyEval := service.NewPolicyEvaluator(policyRepo)
-	repos := service.Repositories{
+	return buildOrchestratorWithConfig(llm, k8sMock, policyRepo, notifier, testRepos(actionRepo), service.OrchestratorConfig{
+		NotifyNoAction: true,
+	})
+}
+
+func testRepos(actionRepo *mockActionRepo) service.Repositories {
+	return service.Repositories{
 		Alerts:        newMockAlertRepo(),
 		Analyses:      &mockAnalysisRepo{},
 		Actions:       actionRepo,
 		Audits:        &mockAuditRepo{},
 		Conversations: newMockConversationRepo(),
 	}
-	return service.NewOrchestrator(analyzer, planner, policyEval, notifier, k8sMock, repos, slog.Default())
+}
+
+func buildOrchestratorWithConfig(
+	llm outbound.LLMProvider,
+	k8sMock outbound.K8sExecutor,
+	policyRepo outbound.PolicyRepository,
+	notifier outbound.Notifier,
+	repos service.Repositories,
+	cfg service.OrchestratorConfig,
+) *service.Orchestrator {
+	analyzer := service.NewAnalyzer(llm, k8sMock)
+	planner := service.NewActionPlanner(k8sMock)
+	policyEval := service.NewPolicyEvaluator(policyRepo)
+	return service.NewOrchestrator(analyzer, planner, policyEval, notifier, k8sMock, repos, cfg, slog.Default())
 }
 
 // --- tests ---
@@ -292,6 +321,60 @@ func TestOrchestrator_HandleAlert_ApprovalRequired(t *testing.T) {
 	}
 }
 
+func TestOrchestrator_HandleAlert_ApprovalCarriesReversible(t *testing.T) {
+	tests := []struct {
+		name       string
+		reversible bool
+	}{
+		{name: "reversible", reversible: true},
+		{name: "irreversible", reversible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := &mockLLM{
+				diagnoseResult: outbound.DiagnosisResult{
+					RootCause:  "stuck rollout",
+					Severity:   "warning",
+					Confidence: 0.8,
+					SuggestedActions: []outbound.SuggestedAction{
+						{Description: "restart", Commands: []string{"kubectl rollout restart deployment/app"}, Risk: "low", Reversible: tt.reversible},
+					},
+				},
+			}
+			k8sMock := &mockK8s{
+				resourceResult: outbound.ResourceResult{Raw: "pod info"},
+				validateResult: outbound.CommandValidation{Allowed: true, Risk: "low"},
+			}
+			policyRepo := &mockPolicyRepo{
+				policy: model.EnvironmentPolicy{
+					Environment: "prod",
+					Mode:        model.PolicyModeApprovalRequired,
+					MaxAutoRisk: "low",
+					Enabled:     true,
+				},
+			}
+			notifier := &mockNotifier{threadID: "thread-rev"}
+			actionRepo := newMockActionRepo()
+
+			orch := buildOrchestrator(llm, k8sMock, policyRepo, notifier, actionRepo)
+
+			alert := testAlert()
+			alert.Environment = "prod"
+
+			if err := orch.HandleAlert(context.Background(), alert); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !notifier.requestApprovalCalled {
+				t.Fatalf("expected approval request for prod policy")
+			}
+			if notifier.lastApproval.Reversible != tt.reversible {
+				t.Errorf("expected approval request Reversible=%v, got %v", tt.reversible, notifier.lastApproval.Reversible)
+			}
+		})
+	}
+}
+
 func TestOrchestrator_HandleApproval_Approve(t *testing.T) {
 	llm := &mockLLM{}
 	k8sMock := &mockK8s{
@@ -387,6 +470,46 @@ func TestOrchestrator_HandleMessage(t *testing.T) {
 	}
 }
 
+func TestOrchestrator_HandleMessage_ProtectedNamespaceOmitsActions(t *testing.T) {
+	llm := &mockLLM{
+		converseResult: outbound.ConversationResponse{
+			Reply:         "CoreDNS is crashlooping.",
+			NeedsApproval: true,
+			SuggestedActions: []outbound.SuggestedAction{
+				{Description: "restart coredns", Commands: []string{"kubectl rollout restart deployment/coredns"}, Risk: "low"},
+			},
+		},
+	}
+	k8sMock := &mockK8s{blockedNS: []string{"kube-system"}}
+	policyRepo := &mockPolicyRepo{
+		policy: model.EnvironmentPolicy{Enabled: true, Mode: model.PolicyModeAutoFix, MaxAutoRisk: "high"},
+	}
+	repos := testRepos(newMockActionRepo())
+	alert := model.NewAlert(model.AlertSourceGrafana, model.SeverityCritical, "CoreDNS down", "dns failing", "dev", "kube-system")
+	repos.Alerts.(*mockAlertRepo).alerts[alert.ID] = alert
+
+	orch := buildOrchestratorWithConfig(llm, k8sMock, policyRepo, &mockNotifier{}, repos,
+		service.OrchestratorConfig{NotifyNoAction: true})
+
+	resp, err := orch.HandleMessage(context.Background(), inbound.MessageRequest{
+		ThreadID:  "thread-kube-system",
+		ChannelID: "channel-1",
+		UserID:    "user-1",
+		UserName:  "alice",
+		Text:      "How do I fix it?",
+		AlertID:   alert.ID,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.SuggestedActions) != 0 || resp.NeedsApproval {
+		t.Errorf("expected no actions for protected namespace, got %+v (needsApproval=%v)", resp.SuggestedActions, resp.NeedsApproval)
+	}
+	if !strings.Contains(resp.Text, "CoreDNS is crashlooping.") || !strings.Contains(resp.Text, "protected") {
+		t.Errorf("expected reply with protected-namespace note, got: %s", resp.Text)
+	}
+}
+
 func TestOrchestrator_ReceiveAlerts_Batch(t *testing.T) {
 	llm := &mockLLM{
 		diagnoseResult: outbound.DiagnosisResult{
@@ -411,3 +534,159 @@ func TestOrchestrator_ReceiveAlerts_Batch(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestOrchestrator_HandleAlert_NoActionNotifies(t *testing.T) {
+	tests := []struct {
+		name         string
+		notify       bool
+		wantMessages int
+	}{
+		{name: "enabled", notify: true, wantMessages: 1},
+		{name: "disabled", notify: false, wantMessages: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			llm := &mockLLM{
+				diagnoseResult: outbound.DiagnosisResult{
+					RootCause:  "upstream provider outage",
+					Severity:   "critical",
+					Confidence: 0.7,
+				},
+			}
+			k8sMock := &mockK8s{
+				validateResult: outbound.CommandValidation{Allowed: true, Risk: "low"},
+			}
+			policyRepo := &mockPolicyRepo{
+				policy: model.EnvironmentPolicy{Enabled: true, Mode: model.PolicyModeAutoFix, MaxAutoRisk: "high"},
+			}
+			notifier := &mockNotifier{threadID: "thread-none"}
+			repos := testRepos(newMockActionRepo())
+
+			orch := buildOrchestratorWithConfig(llm, k8sMock, policyRepo, notifier, repos,
+				service.OrchestratorConfig{NotifyNoAction: tt.notify})
+
+			alert := testAlert()
+			if err := orch.HandleAlert(context.Background(), alert); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(notifier.messages) != tt.wantMessages {
+				t.Fatalf("expected %d no-action message(s), got %d", tt.wantMessages, len(notifier.messages))
+			}
+			if tt.wantMessages > 0 {
+				msg := notifier.messages[0]
+				if !strings.Contains(msg, "manual intervention needed") {
+					t.Errorf("expected manual intervention notice, got: %s", msg)
+				}
+				if !strings.Contains(msg, "upstream provider outage") {
+					t.Errorf("expected root cause in message, got: %s", msg)
+				}
+			}
+			if got := repos.Alerts.(*mockAlertRepo).alerts[alert.ID].Status; got != model.AlertStatusManualRequired {
+				t.Errorf("expected alert status %q, got %q", model.AlertStatusManualRequired, got)
+			}
+		})
+	}
+}
+
+func TestOrchestrator_HandleAlert_AllActionsRejectedNeedsManual(t *testing.T) {
+	llm := &mockLLM{
+		diagnoseResult: outbound.DiagnosisResult{
+			RootCause:  "memory leak",
+			Severity:   "warning",
+			Confidence: 0.8,
+			SuggestedActions: []outbound.SuggestedAction{
+				{Description: "restart pod", Commands: []string{"kubectl rollout restart deployment/app"}, Risk: "low"},
+			},
+		},
+	}
+	k8sMock := &mockK8s{
+		validateResult: outbound.CommandValidation{Allowed: true, Risk: "low"},
+	}
+	// A disabled policy rejects every action.
+	policyRepo := &mockPolicyRepo{
+		policy: model.EnvironmentPolicy{Enabled: false, Mode: model.PolicyModeAutoFix, MaxAutoRisk: "high"},
+	}
+	notifier := &mockNotifier{threadID: "thread-rejected"}
+	actionRepo := newMockActionRepo()
+	repos := testRepos(actionRepo)
+
+	orch := buildOrchestratorWithConfig(llm, k8sMock, policyRepo, notifier, repos,
+		service.OrchestratorConfig{NotifyNoAction: true})
+
+	alert := testAlert()
+	if err := orch.HandleAlert(context.Background(), alert); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(actionRepo.actions) != 1 {
+		t.Fatalf("expected the rejected action to be recorded, got %d", len(actionRepo.actions))
+	}
+	for _, a := range actionRepo.actions {
+		if a.Status != model.ActionStatusRejected {
+			t.Errorf("expected action status %q, got %q", model.ActionStatusRejected, a.Status)
+		}
+	}
+	if len(notifier.messages) != 1 || !strings.Contains(notifier.messages[0], "blocked by policy") {
+		t.Errorf("expected a single blocked-by-policy notice, got %v", notifier.messages)
+	}
+	if got := repos.Alerts.(*mockAlertRepo).alerts[alert.ID].Status; got != model.AlertStatusManualRequired {
+		t.Errorf("expected alert status %q, got %q", model.AlertStatusManualRequired, got)
+	}
+}
+
+func TestOrchestrator_HandleAlert_BlockedNamespaceSkipsRemediation(t *testing.T) {
+	llm := &mockLLM{
+		diagnoseResult: outbound.DiagnosisResult{
+			RootCause:  "coredns crashloop",
+			Severity:   "critical",
+			Confidence: 0.9,
+			SuggestedActions: []outbound.SuggestedAction{
+				{Description: "restart coredns", Commands: []string{"kubectl rollout restart deployment/coredns"}, Risk: "low"},
+			},
+		},
+	}
+	k8sMock := &mockK8s{
+		validateResult: outbound.CommandValidation{Allowed: true, Risk: "low"},
+		execResult:     outbound.ExecResult{Stdout: "restarted", ExitCode: 0},
+		blockedNS:      []string{"kube-system"},
+	}
+	policyRepo := &mockPolicyRepo{
+		policy: model.EnvironmentPolicy{Enabled: true, Mode: model.PolicyModeAutoFix, MaxAutoRisk: "high"},
+	}
+	notifier := &mockNotifier{threadID: "thread-kube-system"}
+	actionRepo := newMockActionRepo()
+	repos := testRepos(actionRepo)
+
+	orch := buildOrchestratorWithConfig(llm, k8sMock, policyRepo, notifier, repos,
+		service.OrchestratorConfig{NotifyNoAction: true})
+
+	alert := model.NewAlert(model.AlertSourceGrafana, model.SeverityCritical, "CoreDNS down", "dns failing", "dev", "kube-system")
+
+	if err := orch.HandleAlert(context.Background(), alert); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(actionRepo.actions) != 0 {
+		t.Errorf("expected no actions for protected namespace, got %d", len(actionRepo.actions))
+	}
+	if notifier.requestApprovalCalled {
+		t.Errorf("expected no approval request for protected namespace")
+	}
+	if len(notifier.messages) != 1 || !strings.Contains(notifier.messages[0], "protected") {
+		t.Errorf("expected a single protected-namespace notice, got %v", notifier.messages)
+	}
+	if got := repos.Alerts.(*mockAlertRepo).alerts[alert.ID].Status; got != model.AlertStatusManualRequired {
+		t.Errorf("expected alert status %q, got %q", model.AlertStatusManualRequired, got)
+	}
+	var skipped int
+	for _, l := range repos.Audits.(*mockAuditRepo).logs {
+		switch l.EventType {
+		case model.AuditRemediationSkipped:
+			skipped++
+		case model.AuditPolicyEvaluated:
+			t.Errorf("expected no policy evaluation for protected namespace, got: %s", l.Description)
+		}
+	}
+	if skipped != 1 {
+		t.Errorf("expected 1 remediation-skipped audit entry, got %d", skipped)
+	}
+}
@@ -63,6 +63,17 @@ func (e *PolicyEvaluator) Evaluate(ctx context.Context, environment string, acti
 
 	actionRisk := string(action.Risk)
 
+	if !action.Reversible && policy.IrreversibleRequiresApproval && !policy.RequiresApproval() {
+		return PolicyDecision{
+			Allowed:       true,
+			NeedsApproval: true,
+			AutoExecute:   false,
+			Reason:        fmt.Sprintf("%s policy: action is irreversible; approval required for env %q", policy.Mode, environment),
+			Approvers:     policy.Approvers,
+			MaxRiskLevel:  policy.MaxAutoRisk,
+		}, nil
+	}
+
 	switch policy.Mode {
 	case model.PolicyModeAutoFix:
 		if !e.isRiskAcceptable(actionRisk, policy.MaxAutoRisk) {
@@ -29,6 +29,10 @@ func (m *mockPolicyRepo) Upsert(_ context.Context, _ model.EnvironmentPolicy) er
 	return nil
 }
 
+func (m *mockPolicyRepo) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
 // Ensure mockPolicyRepo satisfies the interface.
 var _ outbound.PolicyRepository = (*mockPolicyRepo)(nil)
 
@@ -40,6 +44,10 @@ func highRiskAction() model.Action {
 	return model.NewAction("aid", "alid", model.ActionTypeDeletePod, "delete pod", []string{"kubectl delete pod foo"}, model.RiskHigh)
 }
 
+func reversibleAction() model.Action {
+	return lowRiskAction().WithReversible(true)
+}
+
 func TestPolicyEvaluator_DevAutoFix(t *testing.T) {
 	repo := &mockPolicyRepo{
 		policy: model.EnvironmentPolicy{
@@ -218,3 +226,64 @@ func TestIsRiskAcceptable(t *testing.T) {
 		}
 	}
 }
+
+func TestPolicyEvaluator_AutoFix_Irreversible(t *testing.T) {
+	cases := []struct {
+		name         string
+		gate         bool
+		action       model.Action
+		wantApproval bool
+	}{
+		{"reversible, gate on", true, reversibleAction(), false},
+		{"irreversible, gate on", true, lowRiskAction(), true},
+		{"irreversible, gate off", false, lowRiskAction(), false},
+	}
+	for _, tc := range cases {
+		repo := &mockPolicyRepo{
+			policy: model.EnvironmentPolicy{
+				Environment:                  "dev",
+				Mode:                         model.PolicyModeAutoFix,
+				MaxAutoRisk:                  "high",
+				Approvers:                    []string{"alice"},
+				Enabled:                      true,
+				IrreversibleRequiresApproval: tc.gate,
+			},
+		}
+		eval := service.NewPolicyEvaluator(repo)
+
+		decision, err := eval.Evaluate(context.Background(), "dev", tc.action)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if decision.NeedsApproval != tc.wantApproval {
+			t.Errorf("%s: want NeedsApproval=%v got %v", tc.name, tc.wantApproval, decision.NeedsApproval)
+		}
+		if decision.AutoExecute == tc.wantApproval {
+			t.Errorf("%s: want AutoExecute=%v got %v", tc.name, !tc.wantApproval, decision.AutoExecute)
+		}
+		if tc.wantApproval && len(decision.Approvers) == 0 {
+			t.Errorf("%s: expected Approvers to be populated", tc.name)
+		}
+	}
+}
+
+func TestPolicyEvaluator_WarnAuto_Irreversible(t *testing.T) {
+	repo := &mockPolicyRepo{
+		policy: model.EnvironmentPolicy{
+			Environment:                  "staging",
+			Mode:                         model.PolicyModeWarnAuto,
+			MaxAutoRisk:                  "medium",
+			Enabled:                      true,
+			IrreversibleRequiresApproval: true,
+		},
+	}
+	eval := service.NewPolicyEvaluator(repo)
+
+	decision, err := eval.Evaluate(context.Background(), "staging", lowRiskAction())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decision.NeedsApproval {
+		t.Errorf("expected NeedsApproval=true for irreversible action in warn_auto")
+	}
+}